@@ -0,0 +1,6 @@
+data "netbox_asn_ranges" "private" {
+  filter {
+    name  = "rir"
+    value = "private-asns"
+  }
+}
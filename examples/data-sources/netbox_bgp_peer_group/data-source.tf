@@ -0,0 +1,3 @@
+data "netbox_bgp_peer_group" "transit" {
+  name = "transit"
+}
@@ -0,0 +1,7 @@
+data "netbox_bgp_prefix_list" "customers_in" {
+  name = "customers-in"
+}
+
+output "customers_in_prefixes" {
+  value = [for rule in data.netbox_bgp_prefix_list.customers_in.rule : rule.prefix if rule.action == "permit"]
+}
@@ -0,0 +1,3 @@
+data "netbox_bgp_routing_policy" "transit_in" {
+  name = "transit-in"
+}
@@ -0,0 +1,11 @@
+data "netbox_devices" "edge" {
+  filter {
+    name  = "name"
+    value = "edge-1"
+  }
+}
+
+data "netbox_bgp_session" "transit_a" {
+  name      = "transit-a"
+  device_id = data.netbox_devices.edge.devices[0].device_id
+}
@@ -0,0 +1,7 @@
+data "netbox_branch" "review" {
+  name = "review-123"
+}
+
+output "review_branch_status" {
+  value = data.netbox_branch.review.status
+}
@@ -0,0 +1,26 @@
+# Render the changed template code against a real device during plan, so CI
+# fails before the template is stored in NetBox
+data "netbox_config_template_render" "leaf_preview" {
+  device_id          = netbox_device.leaf01.id
+  template_code      = file("${path.module}/templates/leaf.j2")
+  environment_params = jsonencode({ trim_blocks = true })
+}
+
+resource "netbox_config_template" "leaf" {
+  name               = "leaf"
+  template_code      = file("${path.module}/templates/leaf.j2")
+  environment_params = jsonencode({ trim_blocks = true })
+
+  lifecycle {
+    precondition {
+      condition     = length(data.netbox_config_template_render.leaf_preview.content) > 0
+      error_message = "The leaf template renders to an empty configuration."
+    }
+  }
+}
+
+# Render the template as currently stored in NetBox for another device
+data "netbox_config_template_render" "leaf02" {
+  config_template_id = netbox_config_template.leaf.id
+  device_id          = netbox_device.leaf02.id
+}
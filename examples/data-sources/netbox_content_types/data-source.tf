@@ -0,0 +1,12 @@
+data "netbox_content_types" "dcim" {
+  filter {
+    name  = "app_label"
+    value = "dcim"
+  }
+}
+
+resource "netbox_permission" "dcim_read_only" {
+  name         = "dcim-read-only"
+  object_types = data.netbox_content_types.dcim.content_types[*].name
+  actions      = ["view"]
+}
@@ -0,0 +1,8 @@
+data "netbox_data_file" "leaf_template" {
+  source_id = netbox_data_source.configs.id
+  path      = "templates/leaf.j2"
+}
+
+output "leaf_template_hash" {
+  value = data.netbox_data_file.leaf_template.hash
+}
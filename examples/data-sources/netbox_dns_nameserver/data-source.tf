@@ -0,0 +1,3 @@
+data "netbox_dns_nameserver" "ns1" {
+  name = "ns1.example.com"
+}
@@ -0,0 +1,5 @@
+data "netbox_dns_record" "www" {
+  zone_id = data.netbox_dns_zone.example.id
+  name    = "www"
+  type    = "A"
+}
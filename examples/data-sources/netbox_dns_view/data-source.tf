@@ -0,0 +1,3 @@
+data "netbox_dns_view" "internal" {
+  name = "internal"
+}
@@ -0,0 +1,8 @@
+data "netbox_dns_view" "internal" {
+  name = "internal"
+}
+
+data "netbox_dns_zone" "example" {
+  name    = "example.com"
+  view_id = data.netbox_dns_view.internal.id
+}
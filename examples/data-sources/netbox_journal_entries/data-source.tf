@@ -0,0 +1,11 @@
+data "netbox_journal_entries" "device_history" {
+  filter {
+    name  = "assigned_object_type"
+    value = "dcim.device"
+  }
+  filter {
+    name  = "assigned_object_id"
+    value = netbox_device.test.id
+  }
+  limit = 10
+}
@@ -0,0 +1,19 @@
+# All changes of a pipeline run, given the user the pipeline authenticates as
+# and the start and end of the run
+data "netbox_object_changes" "run" {
+  user        = "terraform"
+  time_after  = var.pipeline_started_at
+  time_before = var.pipeline_finished_at
+}
+
+# All sites deleted by the automation user this year
+data "netbox_object_changes" "deleted_sites" {
+  user        = "terraform"
+  object_type = "dcim.site"
+  action      = "delete"
+  time_after  = "2024-01-01T00:00:00Z"
+}
+
+output "deleted_sites" {
+  value = [for change in data.netbox_object_changes.deleted_sites.object_changes : jsondecode(change.prechange_data).name]
+}
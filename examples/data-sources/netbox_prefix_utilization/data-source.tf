@@ -0,0 +1,22 @@
+data "netbox_prefix" "pool" {
+  prefix = "10.0.0.0/24"
+}
+
+data "netbox_prefix_utilization" "pool" {
+  prefix_id = data.netbox_prefix.pool.id
+}
+
+# Fail the plan when the pool runs out of space
+check "pool_utilization" {
+  assert {
+    condition     = data.netbox_prefix_utilization.pool.utilization < 80
+    error_message = "Prefix ${data.netbox_prefix_utilization.pool.prefix} is ${data.netbox_prefix_utilization.pool.utilization}% utilized."
+  }
+}
+
+# Only list the active direct children
+data "netbox_prefix_utilization" "container" {
+  prefix_id    = data.netbox_prefix.pool.id
+  child_depth  = 1
+  child_status = "active"
+}
@@ -0,0 +1,11 @@
+data "netbox_script" "build_rack" {
+  module = "build_rack"
+  name   = "BuildRack"
+}
+
+resource "netbox_script_run" "rack_buildout" {
+  script_id = data.netbox_script.build_rack.script_id
+  data = jsonencode({
+    rack_name = "R101"
+  })
+}
@@ -0,0 +1,13 @@
+# Get the rendered configuration for a virtual machine
+data "netbox_virtual_machine_render_config" "vm_config" {
+  virtual_machine_id = 42
+}
+
+# Use the rendered configuration, e.g. as cloud-init user data
+output "rendered_config" {
+  value = data.netbox_virtual_machine_render_config.vm_config.content
+}
+
+output "template_used" {
+  value = data.netbox_virtual_machine_render_config.vm_config.config_template_name
+}
@@ -12,3 +12,22 @@ provider "netbox" {
   server_url = "https://demo.netbox.dev"
   api_token  = "<your api key>"
 }
+
+# example provider configuration for a Netbox behind an mTLS gateway, with
+# the token rendered to a file by Vault Agent
+provider "netbox" {
+  alias            = "mtls"
+  server_url       = "https://netbox.example.com"
+  api_token_file   = "/run/secrets/netbox-token"
+  client_cert_file = "/etc/pki/netbox/client.crt"
+  client_key_file  = "/etc/pki/netbox/client.key"
+}
+
+# example provider configuration that stages all changes in a branch of the
+# netbox-branching plugin instead of applying them to main
+provider "netbox" {
+  alias      = "review"
+  server_url = "https://netbox.example.com"
+  api_token  = "<your api key>"
+  branch     = "review-123"
+}
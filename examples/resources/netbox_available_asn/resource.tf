@@ -0,0 +1,5 @@
+# Assume a netbox_asn_range resource exists
+resource "netbox_available_asn" "leaf01" {
+  asn_range_id = netbox_asn_range.datacenter.id
+  description  = "leaf01"
+}
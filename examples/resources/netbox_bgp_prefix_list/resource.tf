@@ -0,0 +1,23 @@
+resource "netbox_prefix" "customers" {
+  prefix = "198.51.100.0/24"
+  status = "active"
+}
+
+resource "netbox_bgp_prefix_list" "customers_in" {
+  name   = "customers-in"
+  family = "ipv4"
+
+  rule {
+    index     = 10
+    action    = "permit"
+    prefix_id = netbox_prefix.customers.id
+    le        = 28
+  }
+
+  rule {
+    index  = 20
+    action = "deny"
+    prefix = "0.0.0.0/0"
+    le     = 32
+  }
+}
@@ -0,0 +1,4 @@
+resource "netbox_bgp_routing_policy" "transit_in" {
+  name        = "transit-in"
+  description = "Routes accepted from transit providers"
+}
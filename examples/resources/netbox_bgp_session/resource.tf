@@ -0,0 +1,35 @@
+resource "netbox_rir" "private" {
+  name       = "RFC 6996"
+  is_private = true
+}
+
+resource "netbox_asn" "local" {
+  asn    = 64512
+  rir_id = netbox_rir.private.id
+}
+
+resource "netbox_asn" "remote" {
+  asn    = 64513
+  rir_id = netbox_rir.private.id
+}
+
+resource "netbox_ip_address" "local" {
+  ip_address = "192.0.2.1/31"
+  status     = "active"
+}
+
+resource "netbox_ip_address" "remote" {
+  ip_address = "192.0.2.0/31"
+  status     = "active"
+}
+
+resource "netbox_bgp_session" "transit_a" {
+  name              = "transit-a"
+  device_id         = netbox_device.edge.id
+  local_address_id  = netbox_ip_address.local.id
+  remote_address_id = netbox_ip_address.remote.id
+  local_as_id       = netbox_asn.local.id
+  remote_as_id      = netbox_asn.remote.id
+  peer_group_id     = netbox_bgp_peer_group.transit.id
+  prefix_list_in_id = netbox_bgp_prefix_list.customers_in.id
+}
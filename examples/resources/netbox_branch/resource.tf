@@ -0,0 +1,12 @@
+resource "netbox_branch" "review" {
+  name        = "review-123"
+  description = "Changes of pipeline 123"
+
+  # Set to true once the changes in the branch have been reviewed
+  merged = false
+
+  # Changing any of these values syncs the branch with main
+  sync_triggers = {
+    main_revision = "2024-05-01"
+  }
+}
@@ -0,0 +1,9 @@
+resource "netbox_custom_link" "monitoring" {
+  name         = "Monitoring"
+  object_types = ["dcim.device", "virtualization.virtualmachine"]
+  link_text    = "Open in NMS"
+  link_url     = "https://nms.example.com/hosts/{{ object.name }}"
+  group_name   = "External"
+  button_class = "blue"
+  new_window   = true
+}
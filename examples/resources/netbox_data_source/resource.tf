@@ -0,0 +1,11 @@
+resource "netbox_data_source" "configs" {
+  name       = "network-configs"
+  type       = "git"
+  source_url = "https://git.example.com/network/configs.git"
+  parameters = jsonencode({
+    username = "netbox"
+    password = var.git_token
+    branch   = "main"
+  })
+  ignore_rules = ["*.md"]
+}
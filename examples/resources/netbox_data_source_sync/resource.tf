@@ -0,0 +1,17 @@
+resource "netbox_data_source_sync" "configs" {
+  data_source_id = netbox_data_source.configs.id
+
+  # Synchronize again whenever a new commit is deployed
+  triggers_replace = {
+    commit = var.commit_sha
+  }
+}
+
+resource "netbox_config_template" "leaf" {
+  name              = "leaf"
+  data_source_id    = netbox_data_source.configs.id
+  data_file_path    = "templates/leaf.j2"
+  auto_sync_enabled = true
+
+  depends_on = [netbox_data_source_sync.configs]
+}
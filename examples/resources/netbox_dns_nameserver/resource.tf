@@ -0,0 +1,3 @@
+resource "netbox_dns_nameserver" "ns1" {
+  name = "ns1.example.com"
+}
@@ -0,0 +1,13 @@
+resource "netbox_ip_address" "web" {
+  ip_address = "192.0.2.10/24"
+  status     = "active"
+  dns_name   = "www.example.com"
+}
+
+# Create the record from the IP address, the plugin maintains the PTR record
+resource "netbox_dns_record" "web" {
+  zone_id = netbox_dns_zone.example.id
+  name    = "www"
+  type    = "A"
+  value   = split("/", netbox_ip_address.web.ip_address)[0]
+}
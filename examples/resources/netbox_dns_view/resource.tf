@@ -0,0 +1,4 @@
+resource "netbox_dns_view" "internal" {
+  name        = "internal"
+  description = "Zones resolvable from the internal network"
+}
@@ -0,0 +1,11 @@
+resource "netbox_dns_nameserver" "ns1" {
+  name = "ns1.example.com"
+}
+
+resource "netbox_dns_zone" "example" {
+  name         = "example.com"
+  nameservers  = [netbox_dns_nameserver.ns1.id]
+  soa_mname_id = netbox_dns_nameserver.ns1.id
+  soa_rname    = "hostmaster.example.com"
+  default_ttl  = 3600
+}
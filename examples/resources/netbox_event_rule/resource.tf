@@ -20,9 +20,26 @@ resource "netbox_event_rule" "webhook" {
 }
 
 resource "netbox_event_rule" "script" {
-  name             = "my-script-event-rule"
-  content_types    = ["dcim.site"]
-  action_type      = "script"
-  action_object_id = 42 # existing NetBox Script ID
-  event_types      = ["object_created"]
+  name               = "my-script-event-rule"
+  content_types      = ["dcim.site"]
+  action_type        = "script"
+  action_object_name = "site_setup.SetupSite" # <module>.<class name> of an existing NetBox script
+  event_types        = ["object_created"]
+}
+
+resource "netbox_notification_group" "oncall" {
+  name      = "network-oncall"
+  group_ids = [10]
+}
+
+resource "netbox_event_rule" "notification" {
+  name               = "core-router-changed"
+  content_types      = ["dcim.device"]
+  action_type        = "notification"
+  action_object_name = netbox_notification_group.oncall.name
+  event_types        = ["object_updated"]
+  conditions = jsonencode({
+    attr  = "role.slug"
+    value = "core-router"
+  })
 }
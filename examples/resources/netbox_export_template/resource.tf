@@ -0,0 +1,10 @@
+resource "netbox_export_template" "hosts" {
+  name           = "Hosts file"
+  object_types   = ["dcim.device"]
+  template_code  = <<-EOT
+    {% for device in queryset %}{% if device.primary_ip %}{{ device.primary_ip.address.ip }} {{ device.name }}
+    {% endif %}{% endfor %}
+  EOT
+  mime_type      = "text/plain"
+  file_extension = "txt"
+}
@@ -0,0 +1,14 @@
+variable "pipeline_run" {
+  type = string
+}
+
+variable "commit_sha" {
+  type = string
+}
+
+resource "netbox_journal_entry" "deployment" {
+  assigned_object_type = "dcim.device"
+  assigned_object_id   = netbox_device.test.id
+  kind                 = "success"
+  comments             = "Configuration deployed by pipeline run `${var.pipeline_run}` (commit `${var.commit_sha}`)."
+}
@@ -0,0 +1,15 @@
+resource "netbox_group" "network" {
+  name = "network-engineers"
+}
+
+resource "netbox_user" "oncall" {
+  username = "oncall"
+  password = "Abcdefghijkl1"
+}
+
+resource "netbox_notification_group" "oncall" {
+  name        = "network-oncall"
+  description = "Notified about changes to core network devices"
+  user_ids    = [netbox_user.oncall.id]
+  group_ids   = [netbox_group.network.id]
+}
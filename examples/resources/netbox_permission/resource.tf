@@ -16,3 +16,31 @@ resource "netbox_permission" "test" {
     "status" = "active"
   }])
 }
+
+# Allow changes to active devices in two data centers, and to devices
+# owned by the user the permission is evaluated for. Values are JSON encoded,
+# so that booleans, numbers and null keep their type
+resource "netbox_permission" "devices" {
+  name         = "devices"
+  object_types = ["dcim.device"]
+  actions      = ["view", "change"]
+  users        = [netbox_user.test.id]
+
+  constraint {
+    condition {
+      field = "status"
+      value = jsonencode("active")
+    }
+    condition {
+      field = "site__slug__in"
+      value = jsonencode(["dc1", "dc2"])
+    }
+  }
+
+  constraint {
+    condition {
+      field = "owner"
+      value = jsonencode("$user")
+    }
+  }
+}
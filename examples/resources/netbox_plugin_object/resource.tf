@@ -0,0 +1,14 @@
+resource "netbox_plugin_object" "example_zone" {
+  path = "plugins/netbox-dns/zones"
+  attributes = jsonencode({
+    name        = "example.com"
+    status      = "active"
+    nameservers = [1]
+    soa_mname   = 1
+  })
+  validate_schema = true
+}
+
+output "example_zone_serial" {
+  value = jsondecode(netbox_plugin_object.example_zone.response).soa_serial
+}
@@ -0,0 +1,8 @@
+resource "netbox_saved_filter" "planned_leafs" {
+  name         = "Planned leaf switches"
+  object_types = ["dcim.device"]
+  parameters = jsonencode({
+    status = ["planned"]
+    role   = ["leaf"]
+  })
+}
@@ -0,0 +1,20 @@
+resource "netbox_script_run" "rack_buildout" {
+  script_id = 12
+  data = jsonencode({
+    site      = netbox_site.test.id
+    rack_name = "R101"
+    units     = 42
+  })
+
+  triggers_replace = {
+    revision = "2"
+  }
+
+  timeouts {
+    create = "30m"
+  }
+}
+
+output "rack_buildout_output" {
+  value = netbox_script_run.rack_buildout.output
+}
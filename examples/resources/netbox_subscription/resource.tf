@@ -0,0 +1,11 @@
+resource "netbox_user" "oncall" {
+  username = "oncall"
+  password = "Abcdefghijkl1"
+}
+
+# Notify the on-call user in NetBox about every change to the core router
+resource "netbox_subscription" "core_router" {
+  object_type = "dcim.device"
+  object_id   = 42
+  user_id     = netbox_user.oncall.id
+}
@@ -10,3 +10,35 @@ resource "netbox_token" "test_basic" {
   write_enabled = false
   expires       = "2036-01-02T15:04:05.000Z"
 }
+
+# A v2 token with a secret generated by NetBox, replaced every 90 days
+resource "netbox_token" "automation" {
+  user_id       = netbox_user.test.id
+  version       = 2
+  write_enabled = true
+  rotation_days = 90
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+output "automation_token" {
+  value     = netbox_token.automation.token
+  sensitive = true
+}
+
+# A v2 token whose secret is kept out of the state, e.g. taken from an
+# ephemeral resource of a secrets manager. Bump key_wo_version to rotate it.
+variable "automation_secret" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "netbox_token" "write_only" {
+  user_id        = netbox_user.test.id
+  version        = 2
+  key_wo         = var.automation_secret
+  key_wo_version = 1
+}
@@ -0,0 +1,10 @@
+resource "netbox_vlan_translation_policy" "example" {
+  name        = "Customer A"
+  description = "VLAN translation towards customer A"
+}
+
+resource "netbox_vlan_translation_rule" "example" {
+  policy_id  = netbox_vlan_translation_policy.example.id
+  local_vid  = 100
+  remote_vid = 200
+}
@@ -0,0 +1,11 @@
+# Assume the netbox_device_interface resources exist
+resource "netbox_wireless_link" "backhaul" {
+  interface_a_id = netbox_device_interface.tower_a_radio.id
+  interface_b_id = netbox_device_interface.tower_b_radio.id
+  ssid           = "backhaul-ab"
+  auth_type      = "wpa-personal"
+  auth_cipher    = "aes"
+  auth_psk       = var.backhaul_psk
+  distance       = 4.2
+  distance_unit  = "km"
+}
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
 
 	"github.com/e-breuninger/terraform-provider-netbox/netbox"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,4 +29,8 @@ func main() {
 			return netbox.Provider()
 		},
 	})
+
+	if err := netbox.ShutdownOpenTelemetry(context.Background()); err != nil {
+		log.Printf("[WARN] Failed to export the OpenTelemetry spans: %s", err)
+	}
 }
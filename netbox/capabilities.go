@@ -0,0 +1,185 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// supportedNetboxVersions are the Netbox versions the provider was tested
+// against. Other versions only get a warning.
+var supportedNetboxVersions = []string{"4.3.0", "4.3.2", "4.3.3", "4.3.4", "4.3.5", "4.3.6", "4.3.7", "4.4.0", "4.4.1", "4.4.2", "4.4.4", "4.4.5", "4.4.6", "4.4.7", "4.4.8", "4.4.9", "4.4.10", "4.5.1", "4.5.2", "4.5.3", "4.5.4", "4.5.5", "4.5.6", "4.5.7", "4.5.8", "4.5.9", "4.5.10", "4.6.0", "4.6.1", "4.6.2", "4.6.3", "4.6.4", "4.6.5"}
+
+// isSupportedNetboxVersion returns whether the provider was tested against the
+// Netbox version.
+func isSupportedNetboxVersion(netboxVersion *version.Version) bool {
+	for _, v := range supportedNetboxVersions {
+		if version.Must(version.NewVersion(v)).Equal(netboxVersion) {
+			return true
+		}
+	}
+	return false
+}
+
+// netboxCapability is the range of Netbox versions that support a resource,
+// data source or attribute, and the plugin it requires.
+type netboxCapability struct {
+	// first version with support, empty if supported by all versions
+	minVersion string
+	// first version without support, empty if still supported
+	maxVersion string
+	// base URL of the required plugin, e.g. "netbox-dns", as listed by
+	// /api/plugins/
+	plugin string
+}
+
+// check returns an error describing the missing support of the Netbox
+// version for the feature, or nil if it is supported.
+func (c netboxCapability) check(feature string, netboxVersion *version.Version) error {
+	if c.minVersion != "" && netboxVersion.LessThan(version.Must(version.NewVersion(c.minVersion))) {
+		return fmt.Errorf("%s requires NetBox >= %s; server is %s", feature, c.minVersion, netboxVersion)
+	}
+	if c.maxVersion != "" && !netboxVersion.LessThan(version.Must(version.NewVersion(c.maxVersion))) {
+		return fmt.Errorf("%s is not supported by NetBox >= %s; server is %s", feature, c.maxVersion, netboxVersion)
+	}
+	return nil
+}
+
+// resourceCapabilities contains the resources and data sources that are
+// not supported by all Netbox versions or require a plugin, keyed by their
+// type.
+var resourceCapabilities = map[string]netboxCapability{
+	"netbox_bgp_peer_group":                                {plugin: "bgp"},
+	"netbox_bgp_prefix_list":                               {plugin: "bgp"},
+	"netbox_bgp_routing_policy":                            {plugin: "bgp"},
+	"netbox_bgp_session":                                   {plugin: "bgp"},
+	"netbox_branch":                                        {plugin: "branching"},
+	"netbox_device_interface_primary_mac_address":          {minVersion: "4.2"},
+	"netbox_dns_nameserver":                                {plugin: "netbox-dns"},
+	"netbox_dns_record":                                    {plugin: "netbox-dns"},
+	"netbox_dns_view":                                      {plugin: "netbox-dns"},
+	"netbox_dns_zone":                                      {plugin: "netbox-dns"},
+	"netbox_mac_address":                                   {minVersion: "4.2"},
+	"netbox_notification_group":                            {minVersion: "4.1"},
+	"netbox_object_changes":                                {minVersion: "4.1"},
+	"netbox_rack_type":                                     {minVersion: "4.1"},
+	"netbox_subscription":                                  {minVersion: "4.1"},
+	"netbox_virtual_machine_interface_primary_mac_address": {minVersion: "4.2"},
+	"netbox_vlan_translation_policy":                       {minVersion: "4.2"},
+	"netbox_vlan_translation_rule":                         {minVersion: "4.2"},
+}
+
+// attributeCapabilities contains the attributes of resources that are not
+// supported by all Netbox versions. They are only checked when set.
+var attributeCapabilities = map[string]map[string]netboxCapability{
+	"netbox_device_interface": {
+		"qinq_svlan_id":              {minVersion: "4.2"},
+		"vlan_translation_policy_id": {minVersion: "4.2"},
+	},
+	"netbox_interface": {
+		"qinq_svlan_id":              {minVersion: "4.2"},
+		"vlan_translation_policy_id": {minVersion: "4.2"},
+	},
+	"netbox_vlan": {
+		"qinq_role":     {minVersion: "4.2"},
+		"qinq_svlan_id": {minVersion: "4.2"},
+	},
+}
+
+// requireCapability checks the capability against the Netbox version and the
+// installed plugins of the provider. Without a known version or known plugins,
+// e.g. with skip_version_check, all features are assumed to be supported.
+func (s *providerState) requireCapability(feature string, capability netboxCapability) error {
+	if capability.plugin != "" && s.installedPlugins != nil && !s.installedPlugins[capability.plugin] {
+		return fmt.Errorf("%s requires the %s plugin, which is not installed in NetBox", feature, capability.plugin)
+	}
+	if s.netboxVersion == nil {
+		return nil
+	}
+	return capability.check(feature, s.netboxVersion)
+}
+
+// getInstalledPlugins returns the base URLs of the plugins with a REST API
+// that are installed in Netbox, e.g. "netbox-dns".
+func getInstalledPlugins(api *providerState) (map[string]bool, error) {
+	var res map[string]string
+	if err := netboxRawRequest(api, "GET", "/plugins/", nil, nil, &res); err != nil {
+		return nil, err
+	}
+
+	plugins := make(map[string]bool, len(res))
+	for name := range res {
+		plugins[name] = true
+	}
+	return plugins, nil
+}
+
+// addCapabilityChecks makes resources that are not supported by the running
+// Netbox, or whose plugin is not installed, fail at plan time, instead of with an error of the API at apply
+// time. Data sources are checked when they are read.
+func addCapabilityChecks(provider *schema.Provider) {
+	for name, def := range provider.ResourcesMap {
+		capability, hasCapability := resourceCapabilities[name]
+		attributes := attributeCapabilities[name]
+		if !hasCapability && len(attributes) == 0 {
+			continue
+		}
+
+		check := capabilityCustomizeDiff(name, capability, attributes)
+		if existingDiff := def.CustomizeDiff; existingDiff != nil {
+			def.CustomizeDiff = customdiff.Sequence(check, existingDiff)
+		} else {
+			def.CustomizeDiff = check
+		}
+	}
+
+	for name, def := range provider.DataSourcesMap {
+		capability, ok := resourceCapabilities[name]
+		if !ok {
+			continue
+		}
+
+		useContextCRUD(def)
+		read := def.ReadContext
+		feature := fmt.Sprintf("`%s`", name)
+		def.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			if err := m.(*providerState).requireCapability(feature, capability); err != nil {
+				return diag.FromErr(err)
+			}
+			return read(ctx, d, m)
+		}
+	}
+}
+
+func capabilityCustomizeDiff(name string, capability netboxCapability, attributes map[string]netboxCapability) schema.CustomizeDiffFunc {
+	// check the attributes in a stable order, for stable errors
+	attributeNames := make([]string, 0, len(attributes))
+	for attribute := range attributes {
+		attributeNames = append(attributeNames, attribute)
+	}
+	sort.Strings(attributeNames)
+
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		api := m.(*providerState)
+		if err := api.requireCapability(fmt.Sprintf("`%s`", name), capability); err != nil {
+			return err
+		}
+
+		for _, attribute := range attributeNames {
+			// values from other resources are unknown during the plan, but set
+			_, ok := d.GetOk(attribute)
+			if !ok && d.NewValueKnown(attribute) {
+				continue
+			}
+			if err := api.requireCapability(fmt.Sprintf("`%s.%s`", name, attribute), attributes[attribute]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,139 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxCapabilityCheck(t *testing.T) {
+	for _, tt := range []struct {
+		capability netboxCapability
+		version    string
+		err        string
+	}{
+		{netboxCapability{minVersion: "4.2"}, "4.2.0", ""},
+		{netboxCapability{minVersion: "4.2"}, "4.5.3", ""},
+		{netboxCapability{minVersion: "4.2"}, "4.1.3", "`netbox_mac_address` requires NetBox >= 4.2; server is 4.1.3"},
+		{netboxCapability{maxVersion: "4.4"}, "4.3.7", ""},
+		{netboxCapability{maxVersion: "4.4"}, "4.4.0", "`netbox_mac_address` is not supported by NetBox >= 4.4; server is 4.4.0"},
+		{netboxCapability{}, "4.1.3", ""},
+	} {
+		err := tt.capability.check("`netbox_mac_address`", version.Must(version.NewVersion(tt.version)))
+		if tt.err == "" {
+			assert.NoError(t, err, tt.version)
+		} else {
+			assert.EqualError(t, err, tt.err)
+		}
+	}
+}
+
+func TestSupportedNetboxVersions(t *testing.T) {
+	for v, supported := range map[string]bool{
+		"4.2.9":  false,
+		"4.3.0":  true,
+		"4.3.1":  false,
+		"4.3.8":  false,
+		"4.4.10": true,
+		"4.6.5":  true,
+		"4.6.6":  false,
+	} {
+		assert.Equal(t, supported, isSupportedNetboxVersion(version.Must(version.NewVersion(v))), v)
+	}
+}
+
+func testCapabilityDiff(t *testing.T, resourceType string, config map[string]interface{}, netboxVersion string) error {
+	state := &providerState{defaultTags: schema.NewSet(schema.HashString, nil)}
+	if netboxVersion != "" {
+		state.netboxVersion = version.Must(version.NewVersion(netboxVersion))
+	}
+
+	res := Provider().ResourcesMap[resourceType]
+	_, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), state)
+	return err
+}
+
+func TestCapabilityCustomizeDiff(t *testing.T) {
+	config := map[string]interface{}{"mac_address": "00:11:22:33:44:55"}
+	assert.EqualError(t, testCapabilityDiff(t, "netbox_mac_address", config, "4.1.3"), "`netbox_mac_address` requires NetBox >= 4.2; server is 4.1.3")
+	assert.NoError(t, testCapabilityDiff(t, "netbox_mac_address", config, "4.2.0"))
+	// without the version check, everything is allowed
+	assert.NoError(t, testCapabilityDiff(t, "netbox_mac_address", config, ""))
+}
+
+func TestCapabilityCustomizeDiff_attribute(t *testing.T) {
+	err := testCapabilityDiff(t, "netbox_vlan", map[string]interface{}{"name": "customers", "vid": 100, "qinq_role": "svlan"}, "4.1.3")
+	assert.EqualError(t, err, "`netbox_vlan.qinq_role` requires NetBox >= 4.2; server is 4.1.3")
+
+	err = testCapabilityDiff(t, "netbox_vlan", map[string]interface{}{"name": "customers", "vid": 100}, "4.1.3")
+	assert.NoError(t, err)
+}
+
+func TestCapabilityCustomizeDiff_plugin(t *testing.T) {
+	config := map[string]interface{}{"name": "example.com"}
+	res := Provider().ResourcesMap["netbox_dns_zone"]
+
+	state := &providerState{defaultTags: schema.NewSet(schema.HashString, nil), installedPlugins: map[string]bool{"branching": true}}
+	_, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), state)
+	assert.EqualError(t, err, "`netbox_dns_zone` requires the netbox-dns plugin, which is not installed in NetBox")
+
+	state.installedPlugins["netbox-dns"] = true
+	_, err = res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), state)
+	assert.NoError(t, err)
+
+	// without the version check, the plugins are unknown
+	state.installedPlugins = nil
+	_, err = res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), state)
+	assert.NoError(t, err)
+}
+
+func TestProviderConfigure_installedPlugins(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/status/":
+			json.NewEncoder(w).Encode(map[string]interface{}{"netbox-version": "4.4.2"})
+		case "/api/plugins/":
+			json.NewEncoder(w).Encode(map[string]interface{}{"netbox-dns": "https://netbox.example.com/api/plugins/netbox-dns/"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"server_url": ts.URL,
+		"api_token":  "0123456789abcdef0123456789abcdef01234567",
+	})
+
+	state, diags := providerConfigure(context.Background(), d)
+	if diags.HasError() {
+		t.Fatalf("providerConfigure returned error: %v", diags)
+	}
+	assert.Equal(t, map[string]bool{"netbox-dns": true}, state.(*providerState).installedPlugins)
+	assert.NoError(t, state.(*providerState).requireCapability("`netbox_dns_zone`", resourceCapabilities["netbox_dns_zone"]))
+	assert.Error(t, state.(*providerState).requireCapability("`netbox_branch`", resourceCapabilities["netbox_branch"]))
+}
+
+func TestCapabilitiesExist(t *testing.T) {
+	provider := Provider()
+	for name := range resourceCapabilities {
+		_, isResource := provider.ResourcesMap[name]
+		_, isDataSource := provider.DataSourcesMap[name]
+		assert.True(t, isResource || isDataSource, name)
+	}
+	for name, attributes := range attributeCapabilities {
+		if assert.Contains(t, provider.ResourcesMap, name) {
+			for attribute := range attributes {
+				assert.Contains(t, provider.ResourcesMap[name].Schema, attribute, name)
+			}
+		}
+	}
+}
@@ -1,14 +1,20 @@
 package netbox
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/http"
-	"strings"
+	"os"
 	"time"
 
 	netboxclient "github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
 	"github.com/goware/urlx"
+	"github.com/hashicorp/go-uuid"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -21,6 +27,21 @@ type Config struct {
 	RequestTimeout              int
 	StripTrailingSlashesFromURL bool
 	CACertFile                  string
+	ClientCertFile              string
+	ClientKeyFile               string
+	ClientCert                  string
+	ClientKey                   string
+	APITokenFile                string
+	APITokenCommand             string
+	RequestID                   string
+	SensitiveHeaders            []string
+	TraceBodyFile               string
+	// LogContext carries the logger that requests are traced to. Without
+	// it, only the trace body file is written.
+	LogContext context.Context
+
+	// bodyDump is the opened TraceBodyFile
+	bodyDump *bodyDumpFile
 }
 
 // customHeaderTransport is a transport that adds the specified headers on
@@ -30,14 +51,22 @@ type customHeaderTransport struct {
 	headers  map[string]interface{}
 }
 
+// requestIDTransport is a transport that sets the X-Request-ID header on every
+// request that does not already carry one.
+type requestIDTransport struct {
+	original  http.RoundTripper
+	requestID string
+}
+
 // Client does the heavy lifting of establishing a base Open API client to Netbox.
 func (cfg *Config) Client() (*netboxclient.NetBoxAPI, error) {
 	log.WithFields(log.Fields{
 		"server_url": cfg.ServerURL,
 	}).Debug("Initializing Netbox client")
 
-	if cfg.APIToken == "" {
-		return nil, fmt.Errorf("missing netbox API key")
+	tokenSource, err := cfg.apiTokenSource()
+	if err != nil {
+		return nil, err
 	}
 
 	// parse serverUrl
@@ -58,16 +87,66 @@ func (cfg *Config) Client() (*netboxclient.NetBoxAPI, error) {
 		InsecureSkipVerify: cfg.AllowInsecureHTTPS,
 	}
 
+	clientCert, err := cfg.clientCertificate()
+	if err != nil {
+		return nil, err
+	}
+	if clientCert != nil {
+		clientOpts.LoadedCertificate = clientCert.Leaf
+		clientOpts.LoadedKey = clientCert.PrivateKey
+	}
+
 	trans, err := httptransport.TLSTransport(clientOpts)
 	if err != nil {
 		return nil, err
 	}
 
 	trans.(*http.Transport).Proxy = http.ProxyFromEnvironment
+	if clientCert != nil {
+		// TLSClientOptions only passes on the leaf, keep the intermediates of the chain
+		trans.(*http.Transport).TLSClientConfig.Certificates = []tls.Certificate{*clientCert}
+	}
+
+	// Trace the requests as they are sent, with all headers and retries
+	logContext := cfg.LogContext
+	if logContext == nil {
+		logContext = context.Background()
+	}
+	// Open the trace body file once, so that clients rebuilt from the same
+	// config share the file and its lock
+	if cfg.TraceBodyFile != "" && cfg.bodyDump == nil {
+		cfg.bodyDump, err = openBodyDumpFile(cfg.TraceBodyFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	tracing := newTracingTransport(logContext, trans, cfg.SensitiveHeaders, cfg.bodyDump)
+	trans = tracing
+
+	// Generate the request ID once, so that clients rebuilt from the same
+	// config share it
+	if cfg.RequestID == "" {
+		cfg.RequestID, err = uuid.GenerateUUID()
+		if err != nil {
+			return nil, fmt.Errorf("error generating request ID: %w", err)
+		}
+	}
+	log.WithFields(log.Fields{
+		"request_id": cfg.RequestID,
+	}).Debug("Sending X-Request-ID on every request to Netbox")
+
+	trans = requestIDTransport{
+		original:  trans,
+		requestID: cfg.RequestID,
+	}
 
 	if len(cfg.Headers) > 0 {
+		customHeaders := make(http.Header, len(cfg.Headers))
+		for key, value := range cfg.Headers {
+			customHeaders.Set(key, fmt.Sprintf("%v", value))
+		}
 		log.WithFields(log.Fields{
-			"custom_headers": cfg.Headers,
+			"custom_headers": tracing.redactHeaders(customHeaders),
 		}).Debug("Setting custom headers on every request to Netbox")
 
 		trans = customHeaderTransport{
@@ -76,6 +155,13 @@ func (cfg *Config) Client() (*netboxclient.NetBoxAPI, error) {
 		}
 	}
 
+	if tokenSource.refreshable() {
+		trans = refreshTokenTransport{
+			original: trans,
+			source:   tokenSource,
+		}
+	}
+
 	httpClient := &http.Client{
 		Transport: trans,
 		Timeout:   time.Second * time.Duration(cfg.RequestTimeout),
@@ -88,17 +174,89 @@ func (cfg *Config) Client() (*netboxclient.NetBoxAPI, error) {
 	}
 
 	transport := httptransport.NewWithClient(parsedURL.Host, parsedURL.Path+netboxclient.DefaultBasePath, desiredRuntimeClientSchemes, httpClient)
-	authScheme := "Token"
-	if strings.HasPrefix(cfg.APIToken, "nbt_") {
-		authScheme = "Bearer"
-	}
-	transport.DefaultAuthentication = httptransport.APIKeyAuth("Authorization", "header", fmt.Sprintf("%s %v", authScheme, cfg.APIToken))
+	transport.DefaultAuthentication = runtime.ClientAuthInfoWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
+		return r.SetHeaderParam("Authorization", tokenSource.authorization())
+	})
+	// The OpenAPI schema of Netbox is served with its own media type
+	transport.Consumers["application/vnd.oai.openapi+json"] = runtime.JSONConsumer()
 	transport.SetLogger(log.StandardLogger())
 	netboxClient := netboxclient.New(transport, nil)
 
 	return netboxClient, nil
 }
 
+// apiTokenSource returns the source of the API token. Only one of the token,
+// the token file and the token command can be set.
+func (cfg *Config) apiTokenSource() (*apiTokenSource, error) {
+	configured := 0
+	for _, value := range []string{cfg.APIToken, cfg.APITokenFile, cfg.APITokenCommand} {
+		if value != "" {
+			configured++
+		}
+	}
+	if configured > 1 {
+		return nil, fmt.Errorf("only one of api_token, api_token_file and api_token_command can be set")
+	}
+
+	switch {
+	case cfg.APITokenFile != "":
+		return newFileAPITokenSource(cfg.APITokenFile)
+	case cfg.APITokenCommand != "":
+		return newCommandAPITokenSource(cfg.APITokenCommand)
+	case cfg.APIToken != "":
+		return newStaticAPITokenSource(cfg.APIToken), nil
+	}
+	return nil, fmt.Errorf("missing netbox API key")
+}
+
+// clientCertificate loads the client certificate for mutual TLS from either
+// files or PEM-encoded content. It returns nil if no client certificate is set.
+func (cfg *Config) clientCertificate() (*tls.Certificate, error) {
+	if cfg.ClientCertFile != "" && cfg.ClientCert != "" {
+		return nil, fmt.Errorf("only one of client_cert_file and client_cert can be set")
+	}
+	if cfg.ClientKeyFile != "" && cfg.ClientKey != "" {
+		return nil, fmt.Errorf("only one of client_key_file and client_key can be set")
+	}
+
+	certPEM := []byte(cfg.ClientCert)
+	if cfg.ClientCertFile != "" {
+		content, err := os.ReadFile(cfg.ClientCertFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading client certificate file: %w", err)
+		}
+		certPEM = content
+	}
+
+	keyPEM := []byte(cfg.ClientKey)
+	if cfg.ClientKeyFile != "" {
+		content, err := os.ReadFile(cfg.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading client key file: %w", err)
+		}
+		keyPEM = content
+	}
+
+	if len(certPEM) == 0 && len(keyPEM) == 0 {
+		return nil, nil
+	}
+	if len(certPEM) == 0 || len(keyPEM) == 0 {
+		return nil, fmt.Errorf("a client certificate requires both a certificate and a key")
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("error loading client certificate: %w", err)
+	}
+	if cert.Leaf == nil {
+		cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			return nil, fmt.Errorf("error parsing client certificate: %w", err)
+		}
+	}
+	return &cert, nil
+}
+
 // RoundTrip adds the headers specified in the transport on every request.
 func (t customHeaderTransport) RoundTrip(r *http.Request) (*http.Response, error) {
 	for key, value := range t.headers {
@@ -108,3 +266,13 @@ func (t customHeaderTransport) RoundTrip(r *http.Request) (*http.Response, error
 	resp, err := t.original.RoundTrip(r)
 	return resp, err
 }
+
+// RoundTrip sets the request ID, unless the header is already set, e.g. by
+// the custom headers.
+func (t requestIDTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Header.Get("X-Request-ID") == "" {
+		r.Header.Set("X-Request-ID", t.requestID)
+	}
+
+	return t.original.RoundTrip(r)
+}
@@ -1,8 +1,19 @@
 package netbox
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
 	"time"
 
@@ -89,6 +100,52 @@ func TestAdditionalHeadersSet(t *testing.T) {
 	client.Status.StatusList(req, nil)
 }
 
+func TestRequestIDIsStablePerConfig(t *testing.T) {
+	var requestIDs []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestIDs = append(requestIDs, r.Header.Get("X-Request-ID"))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	// A rebuilt client keeps the request ID of the config
+	for i := 0; i < 2; i++ {
+		client, err := config.Client()
+		assert.NoError(t, err)
+
+		req := status.NewStatusListParams()
+		client.Status.StatusList(req, nil)
+	}
+
+	assert.Len(t, requestIDs, 2)
+	assert.NotEmpty(t, requestIDs[0])
+	assert.Equal(t, requestIDs[0], requestIDs[1])
+	assert.Equal(t, config.RequestID, requestIDs[0])
+}
+
+func TestRequestIDConfigured(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, []string{"pipeline-4711"}, r.Header.Values("X-Request-ID"))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+		RequestID: "pipeline-4711",
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	client.Status.StatusList(req, nil)
+}
+
 func TestV1TokenUsesTokenScheme(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		auth := r.Header.Get("Authorization")
@@ -143,6 +200,159 @@ func TestRequestTimeoutAppliesToOperationTimeout(t *testing.T) {
 	assert.Equal(t, 90*time.Second, httptransport.DefaultTimeout)
 }
 
+func TestAPITokenFileIsReloadedOnUnauthorized(t *testing.T) {
+	var authorizations []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") != "Token rotated-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "4.4.0"}`))
+	}))
+	defer ts.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("initial-token\n"), 0o600))
+
+	config := Config{
+		APITokenFile: tokenFile,
+		ServerURL:    ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	// Simulate an agent rotating the token after the provider was configured
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("rotated-token\n"), 0o600))
+
+	_, err = client.Status.StatusList(status.NewStatusListParams(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Token initial-token", "Token rotated-token"}, authorizations)
+}
+
+func TestAPITokenCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test command requires a POSIX shell")
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer nbt_abc1234567890abcdef.checksum1234", r.Header.Get("Authorization"))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APITokenCommand: "echo nbt_abc1234567890abcdef.checksum1234",
+		ServerURL:       ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	client.Status.StatusList(req, nil)
+}
+
+func TestAPITokenCommandFailure(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test command requires a POSIX shell")
+	}
+
+	config := Config{
+		APITokenCommand: "echo 'vault is sealed' >&2; exit 1",
+		ServerURL:       "http://localhost",
+	}
+
+	_, err := config.Client()
+	assert.ErrorContains(t, err, "vault is sealed")
+}
+
+func TestMultipleAPITokenSourcesFail(t *testing.T) {
+	config := Config{
+		APIToken:     "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		APITokenFile: "/run/secrets/netbox-token",
+		ServerURL:    "http://localhost",
+	}
+
+	_, err := config.Client()
+	assert.ErrorContains(t, err, "only one of api_token, api_token_file and api_token_command can be set")
+}
+
+// testClientCertificatePEM returns a self-signed client certificate and its key.
+func testClientCertificatePEM(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
+
+func TestClientCertificate(t *testing.T) {
+	certPEM, keyPEM := testClientCertificatePEM(t)
+
+	certFile := filepath.Join(t.TempDir(), "client.crt")
+	keyFile := filepath.Join(t.TempDir(), "client.key")
+	assert.NoError(t, os.WriteFile(certFile, []byte(certPEM), 0o600))
+	assert.NoError(t, os.WriteFile(keyFile, []byte(keyPEM), 0o600))
+
+	for name, config := range map[string]Config{
+		"Files":   {ClientCertFile: certFile, ClientKeyFile: keyFile},
+		"Content": {ClientCert: certPEM, ClientKey: keyPEM},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var commonName string
+			ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				commonName = r.TLS.PeerCertificates[0].Subject.CommonName
+			}))
+			ts.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+			ts.StartTLS()
+			defer ts.Close()
+
+			config.APIToken = "07b12b765127747e4afd56cb531b7bf9c61f3c30"
+			config.ServerURL = ts.URL
+			config.AllowInsecureHTTPS = true
+
+			client, err := config.Client()
+			assert.NoError(t, err)
+
+			req := status.NewStatusListParams()
+			client.Status.StatusList(req, nil)
+			assert.Equal(t, "terraform", commonName)
+		})
+	}
+}
+
+func TestClientCertificateWithoutKeyFails(t *testing.T) {
+	certPEM, _ := testClientCertificatePEM(t)
+
+	config := Config{
+		APIToken:   "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:  "https://localhost",
+		ClientCert: certPEM,
+	}
+
+	_, err := config.Client()
+	assert.ErrorContains(t, err, "a client certificate requires both a certificate and a key")
+}
+
 /* TODO
 func TestInvalidHttpsCertificate(t *testing.T) {}
 */
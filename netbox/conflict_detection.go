@@ -0,0 +1,342 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	lastUpdatedKey = "last_updated"
+
+	conflictDetectionOff   = "off"
+	conflictDetectionWarn  = "warn"
+	conflictDetectionError = "error"
+)
+
+var conflictDetectionModes = []string{conflictDetectionOff, conflictDetectionWarn, conflictDetectionError}
+
+var lastUpdatedSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Computed:    true,
+	Description: "The time of the last change of the object in Netbox made by Terraform. Only populated if `conflict_detection` is enabled on the provider.",
+}
+
+// changeLoggedObject describes where the provider finds the Netbox object of a
+// resource, to check it for out-of-band changes.
+type changeLoggedObject struct {
+	// API path of the object list, e.g. /dcim/devices/
+	path string
+	// object type in the change log, e.g. dcim.device
+	objectType string
+}
+
+// changeLoggedResources contains the resources whose ID is the ID of a change
+// logged Netbox object. Resources that only manage a relation of another
+// object, e.g. netbox_primary_ip, or objects without last_updated, e.g.
+// users and tokens, are not listed.
+var changeLoggedResources = map[string]changeLoggedObject{
+	"netbox_aggregate":                    {"/ipam/aggregates/", "ipam.aggregate"},
+	"netbox_asn":                          {"/ipam/asns/", "ipam.asn"},
+	"netbox_asn_range":                    {"/ipam/asn-ranges/", "ipam.asnrange"},
+	"netbox_available_asn":                {"/ipam/asns/", "ipam.asn"},
+	"netbox_available_ip_address":         {"/ipam/ip-addresses/", "ipam.ipaddress"},
+	"netbox_available_prefix":             {"/ipam/prefixes/", "ipam.prefix"},
+	"netbox_available_vlan":               {"/ipam/vlans/", "ipam.vlan"},
+	"netbox_bgp_peer_group":               {"/plugins/bgp/peer-group/", "netbox_bgp.bgppeergroup"},
+	"netbox_bgp_prefix_list":              {"/plugins/bgp/prefix-list/", "netbox_bgp.prefixlist"},
+	"netbox_bgp_routing_policy":           {"/plugins/bgp/routing-policy/", "netbox_bgp.routingpolicy"},
+	"netbox_bgp_session":                  {"/plugins/bgp/session/", "netbox_bgp.bgpsession"},
+	"netbox_branch":                       {"/plugins/branching/branches/", "netbox_branching.branch"},
+	"netbox_cable":                        {"/dcim/cables/", "dcim.cable"},
+	"netbox_circuit":                      {"/circuits/circuits/", "circuits.circuit"},
+	"netbox_circuit_provider":             {"/circuits/providers/", "circuits.provider"},
+	"netbox_circuit_termination":          {"/circuits/circuit-terminations/", "circuits.circuittermination"},
+	"netbox_circuit_type":                 {"/circuits/circuit-types/", "circuits.circuittype"},
+	"netbox_cluster":                      {"/virtualization/clusters/", "virtualization.cluster"},
+	"netbox_cluster_group":                {"/virtualization/cluster-groups/", "virtualization.clustergroup"},
+	"netbox_cluster_type":                 {"/virtualization/cluster-types/", "virtualization.clustertype"},
+	"netbox_config_context":               {"/extras/config-contexts/", "extras.configcontext"},
+	"netbox_config_template":              {"/extras/config-templates/", "extras.configtemplate"},
+	"netbox_console_port_template":        {"/dcim/console-port-templates/", "dcim.consoleporttemplate"},
+	"netbox_console_server_port_template": {"/dcim/console-server-port-templates/", "dcim.consoleserverporttemplate"},
+	"netbox_contact":                      {"/tenancy/contacts/", "tenancy.contact"},
+	"netbox_contact_assignment":           {"/tenancy/contact-assignments/", "tenancy.contactassignment"},
+	"netbox_contact_group":                {"/tenancy/contact-groups/", "tenancy.contactgroup"},
+	"netbox_contact_role":                 {"/tenancy/contact-roles/", "tenancy.contactrole"},
+	"netbox_custom_field":                 {"/extras/custom-fields/", "extras.customfield"},
+	"netbox_custom_field_choice_set":      {"/extras/custom-field-choice-sets/", "extras.customfieldchoiceset"},
+	"netbox_custom_link":                  {"/extras/custom-links/", "extras.customlink"},
+	"netbox_data_source":                  {"/core/data-sources/", "core.datasource"},
+	"netbox_device":                       {"/dcim/devices/", "dcim.device"},
+	"netbox_device_bay":                   {"/dcim/device-bays/", "dcim.devicebay"},
+	"netbox_device_bay_template":          {"/dcim/device-bay-templates/", "dcim.devicebaytemplate"},
+	"netbox_device_console_port":          {"/dcim/console-ports/", "dcim.consoleport"},
+	"netbox_device_console_server_port":   {"/dcim/console-server-ports/", "dcim.consoleserverport"},
+	"netbox_device_front_port":            {"/dcim/front-ports/", "dcim.frontport"},
+	"netbox_device_interface":             {"/dcim/interfaces/", "dcim.interface"},
+	"netbox_device_module_bay":            {"/dcim/module-bays/", "dcim.modulebay"},
+	"netbox_device_power_outlet":          {"/dcim/power-outlets/", "dcim.poweroutlet"},
+	"netbox_device_power_port":            {"/dcim/power-ports/", "dcim.powerport"},
+	"netbox_device_rear_port":             {"/dcim/rear-ports/", "dcim.rearport"},
+	"netbox_device_role":                  {"/dcim/device-roles/", "dcim.devicerole"},
+	"netbox_device_type":                  {"/dcim/device-types/", "dcim.devicetype"},
+	"netbox_dns_nameserver":               {"/plugins/netbox-dns/nameservers/", "netbox_dns.nameserver"},
+	"netbox_dns_record":                   {"/plugins/netbox-dns/records/", "netbox_dns.record"},
+	"netbox_dns_view":                     {"/plugins/netbox-dns/views/", "netbox_dns.view"},
+	"netbox_dns_zone":                     {"/plugins/netbox-dns/zones/", "netbox_dns.zone"},
+	"netbox_event_rule":                   {"/extras/event-rules/", "extras.eventrule"},
+	"netbox_export_template":              {"/extras/export-templates/", "extras.exporttemplate"},
+	"netbox_fhrp_group":                   {"/ipam/fhrp-groups/", "ipam.fhrpgroup"},
+	"netbox_fhrp_group_assignment":        {"/ipam/fhrp-group-assignments/", "ipam.fhrpgroupassignment"},
+	"netbox_interface":                    {"/virtualization/interfaces/", "virtualization.vminterface"},
+	"netbox_interface_template":           {"/dcim/interface-templates/", "dcim.interfacetemplate"},
+	"netbox_inventory_item":               {"/dcim/inventory-items/", "dcim.inventoryitem"},
+	"netbox_inventory_item_role":          {"/dcim/inventory-item-roles/", "dcim.inventoryitemrole"},
+	"netbox_ip_address":                   {"/ipam/ip-addresses/", "ipam.ipaddress"},
+	"netbox_ip_range":                     {"/ipam/ip-ranges/", "ipam.iprange"},
+	"netbox_ipam_role":                    {"/ipam/roles/", "ipam.role"},
+	"netbox_journal_entry":                {"/extras/journal-entries/", "extras.journalentry"},
+	"netbox_location":                     {"/dcim/locations/", "dcim.location"},
+	"netbox_mac_address":                  {"/dcim/mac-addresses/", "dcim.macaddress"},
+	"netbox_manufacturer":                 {"/dcim/manufacturers/", "dcim.manufacturer"},
+	"netbox_module":                       {"/dcim/modules/", "dcim.module"},
+	"netbox_module_bay_template":          {"/dcim/module-bay-templates/", "dcim.modulebaytemplate"},
+	"netbox_module_type":                  {"/dcim/module-types/", "dcim.moduletype"},
+	"netbox_notification_group":           {"/extras/notification-groups/", "extras.notificationgroup"},
+	"netbox_platform":                     {"/dcim/platforms/", "dcim.platform"},
+	"netbox_power_feed":                   {"/dcim/power-feeds/", "dcim.powerfeed"},
+	"netbox_power_outlet_template":        {"/dcim/power-outlet-templates/", "dcim.poweroutlettemplate"},
+	"netbox_power_panel":                  {"/dcim/power-panels/", "dcim.powerpanel"},
+	"netbox_power_port_template":          {"/dcim/power-port-templates/", "dcim.powerporttemplate"},
+	"netbox_prefix":                       {"/ipam/prefixes/", "ipam.prefix"},
+	"netbox_rack":                         {"/dcim/racks/", "dcim.rack"},
+	"netbox_rack_reservation":             {"/dcim/rack-reservations/", "dcim.rackreservation"},
+	"netbox_rack_role":                    {"/dcim/rack-roles/", "dcim.rackrole"},
+	"netbox_rack_type":                    {"/dcim/rack-types/", "dcim.racktype"},
+	"netbox_rear_port_template":           {"/dcim/rear-port-templates/", "dcim.rearporttemplate"},
+	"netbox_region":                       {"/dcim/regions/", "dcim.region"},
+	"netbox_rir":                          {"/ipam/rirs/", "ipam.rir"},
+	"netbox_route_target":                 {"/ipam/route-targets/", "ipam.routetarget"},
+	"netbox_saved_filter":                 {"/extras/saved-filters/", "extras.savedfilter"},
+	"netbox_service":                      {"/ipam/services/", "ipam.service"},
+	"netbox_site":                         {"/dcim/sites/", "dcim.site"},
+	"netbox_site_group":                   {"/dcim/site-groups/", "dcim.sitegroup"},
+	"netbox_tag":                          {"/extras/tags/", "extras.tag"},
+	"netbox_tenant":                       {"/tenancy/tenants/", "tenancy.tenant"},
+	"netbox_tenant_group":                 {"/tenancy/tenant-groups/", "tenancy.tenantgroup"},
+	"netbox_virtual_chassis":              {"/dcim/virtual-chassis/", "dcim.virtualchassis"},
+	"netbox_virtual_disk":                 {"/virtualization/virtual-disks/", "virtualization.virtualdisk"},
+	"netbox_virtual_machine":              {"/virtualization/virtual-machines/", "virtualization.virtualmachine"},
+	"netbox_vlan":                         {"/ipam/vlans/", "ipam.vlan"},
+	"netbox_vlan_group":                   {"/ipam/vlan-groups/", "ipam.vlangroup"},
+	"netbox_vlan_translation_policy":      {"/ipam/vlan-translation-policies/", "ipam.vlantranslationpolicy"},
+	"netbox_vlan_translation_rule":        {"/ipam/vlan-translation-rules/", "ipam.vlantranslationrule"},
+	"netbox_vpn_tunnel":                   {"/vpn/tunnels/", "vpn.tunnel"},
+	"netbox_vpn_tunnel_group":             {"/vpn/tunnel-groups/", "vpn.tunnelgroup"},
+	"netbox_vpn_tunnel_termination":       {"/vpn/tunnel-terminations/", "vpn.tunneltermination"},
+	"netbox_vrf":                          {"/ipam/vrfs/", "ipam.vrf"},
+	"netbox_webhook":                      {"/extras/webhooks/", "extras.webhook"},
+	"netbox_wireless_lan":                 {"/wireless/wireless-lans/", "wireless.wirelesslan"},
+	"netbox_wireless_lan_group":           {"/wireless/wireless-lan-groups/", "wireless.wirelesslangroup"},
+	"netbox_wireless_link":                {"/wireless/wireless-links/", "wireless.wirelesslink"},
+}
+
+// addConflictDetection adds last_updated to a change logged resource and wraps
+// its CRUD functions to record it and, before every update, to check the
+// object for changes made outside of Terraform. Read only records last_updated
+// of imported objects, otherwise a refresh would absorb the changes made
+// outside of Terraform and they would only be detected if they happen between
+// plan and apply.
+func addConflictDetection(def *schema.Resource, object changeLoggedObject) {
+	def.Schema[lastUpdatedKey] = lastUpdatedSchema
+
+	useContextCRUD(def)
+
+	create := def.CreateContext
+	def.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := create(ctx, d, m)
+		if diags.HasError() {
+			return diags
+		}
+		return append(diags, setLastUpdated(d, m, object)...)
+	}
+
+	read := def.ReadContext
+	def.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := read(ctx, d, m)
+		if diags.HasError() || d.Get(lastUpdatedKey).(string) != "" {
+			return diags
+		}
+		return append(diags, setLastUpdated(d, m, object)...)
+	}
+
+	update := def.UpdateContext
+	if update == nil {
+		// all attributes force a new resource
+		return
+	}
+	def.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := checkConflict(d, m, object)
+		if diags.HasError() {
+			return diags
+		}
+		diags = append(diags, update(ctx, d, m)...)
+		if diags.HasError() {
+			return diags
+		}
+		return append(diags, setLastUpdated(d, m, object)...)
+	}
+}
+
+type lastUpdatedResponse struct {
+	LastUpdated string `json:"last_updated"`
+}
+
+func getLastUpdated(api *providerState, object changeLoggedObject, id string) (string, error) {
+	var res lastUpdatedResponse
+	query := url.Values{"fields": []string{"id,last_updated"}}
+	if err := netboxRawRequest(api, "GET", object.path+id+"/", query, nil, &res); err != nil {
+		return "", err
+	}
+	return res.LastUpdated, nil
+}
+
+// setLastUpdated records the last change of the object in the state, if
+// conflict detection is enabled.
+func setLastUpdated(d *schema.ResourceData, m interface{}, object changeLoggedObject) diag.Diagnostics {
+	api := m.(*providerState)
+	if api.conflictDetection == conflictDetectionOff || api.conflictDetection == "" || d.Id() == "" {
+		return nil
+	}
+
+	lastUpdated, err := getLastUpdated(api, object, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading last_updated of %s %s: %w", object.objectType, d.Id(), err))
+	}
+	return diag.FromErr(d.Set(lastUpdatedKey, lastUpdated))
+}
+
+// checkConflict compares the last change of the object in Netbox with the one
+// in the state. If the object was changed since, it returns a warning or an
+// error, depending on the conflict detection mode.
+func checkConflict(d *schema.ResourceData, m interface{}, object changeLoggedObject) diag.Diagnostics {
+	api := m.(*providerState)
+	if api.conflictDetection == conflictDetectionOff || api.conflictDetection == "" {
+		return nil
+	}
+
+	known := d.Get(lastUpdatedKey).(string)
+	if known == "" {
+		// recorded before conflict detection was enabled
+		return nil
+	}
+
+	lastUpdated, err := getLastUpdated(api, object, d.Id())
+	if err != nil {
+		if apiErr, ok := err.(*netboxRawAPIError); ok && apiErr.Code() == 404 {
+			// the update reports the missing object
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error checking %s %s for conflicting changes: %w", object.objectType, d.Id(), err))
+	}
+	if lastUpdated == known {
+		return nil
+	}
+
+	severity := diag.Warning
+	if api.conflictDetection == conflictDetectionError {
+		severity = diag.Error
+	}
+
+	detail := fmt.Sprintf("%s %s was last updated at %s, but the state was recorded at %s.", object.objectType, d.Id(), lastUpdated, known)
+	if changes, err := getConflictingChanges(api, object, d.Id(), known); err != nil {
+		detail += fmt.Sprintf(" The changes could not be read from the change log: %s", err)
+	} else {
+		detail += "\n\n" + strings.Join(changes, "\n")
+	}
+	if severity == diag.Error {
+		detail += "\n\nSet `conflict_detection = \"warn\"` on the provider to overwrite the changes, or remove the object from the state and import it again to accept them."
+	} else {
+		detail += "\n\nThe changes are overwritten by this update."
+	}
+
+	return diag.Diagnostics{{
+		Severity: severity,
+		Summary:  fmt.Sprintf("%s %s was changed outside of Terraform", object.objectType, d.Id()),
+		Detail:   detail,
+	}}
+}
+
+// getConflictingChanges describes the change log entries of the object made
+// after the given time, one line per entry.
+func getConflictingChanges(api *providerState, object changeLoggedObject, id string, since string) ([]string, error) {
+	sinceTime, err := time.Parse(time.RFC3339Nano, since)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("changed_object_type", object.objectType)
+	query.Set("changed_object_id", id)
+	query.Set("ordering", "-time")
+	changes, err := netboxRawList[objectChange](api, "/core/object-changes/", query, 20)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	for i := len(changes) - 1; i >= 0; i-- {
+		change := changes[i]
+		changeTime, err := time.Parse(time.RFC3339Nano, change.Time)
+		if err != nil || !changeTime.After(sinceTime) {
+			continue
+		}
+
+		line := fmt.Sprintf("- %s by %s", change.Time, change.UserName)
+		if change.Action != nil && change.Action.Value != "update" {
+			line += ": " + change.Action.Value
+		} else if fields := changedFields(change.PrechangeData, change.PostchangeData); len(fields) > 0 {
+			line += ": changed " + joinStringWithFinalConjunction(fields, ", ", "and")
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		return []string{"The change log contains no entries for these changes."}, nil
+	}
+	return lines, nil
+}
+
+// changedFields returns the sorted names of the fields that differ between the
+// pre- and postchange data of a change log entry.
+func changedFields(prechange, postchange json.RawMessage) []string {
+	var before, after map[string]interface{}
+	_ = json.Unmarshal(prechange, &before)
+	_ = json.Unmarshal(postchange, &after)
+
+	var fields []string
+	for key, value := range after {
+		if key == lastUpdatedKey {
+			continue
+		}
+		if !reflect.DeepEqual(before[key], value) {
+			fields = append(fields, key)
+		}
+	}
+	for key := range before {
+		if _, ok := after[key]; !ok && !slices.Contains(fields, key) {
+			fields = append(fields, key)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,173 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// newConflictTestServer returns a mock Netbox with tag 7, which was last
+// updated at the given time by a colleague in the UI.
+func newConflictTestServer(t *testing.T, lastUpdated string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/extras/tags/7/":
+			if r.URL.Query().Get("fields") == "" {
+				// the read of the tag resource itself
+				json.NewEncoder(w).Encode(map[string]interface{}{"id": 7, "name": "prod", "slug": "prod", "color": "00ff00", "last_updated": lastUpdated})
+				return
+			}
+			assert.Equal(t, "id,last_updated", r.URL.Query().Get("fields"))
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": 7, "last_updated": lastUpdated})
+		case "/api/core/object-changes/":
+			assert.Equal(t, "extras.tag", r.URL.Query().Get("changed_object_type"))
+			assert.Equal(t, "7", r.URL.Query().Get("changed_object_id"))
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"count": 2,
+				"results": []map[string]interface{}{
+					{
+						"id":              102,
+						"time":            "2024-05-01T10:05:00.000000Z",
+						"user_name":       "colleague",
+						"action":          map[string]string{"value": "update"},
+						"prechange_data":  map[string]interface{}{"name": "prod", "color": "ff0000", "last_updated": "2024-05-01T10:00:00.000000Z"},
+						"postchange_data": map[string]interface{}{"name": "prod", "color": "00ff00", "description": "edited", "last_updated": "2024-05-01T10:05:00.000000Z"},
+					},
+					{
+						"id":              101,
+						"time":            "2024-05-01T10:00:00.000000Z",
+						"user_name":       "terraform",
+						"action":          map[string]string{"value": "update"},
+						"prechange_data":  map[string]interface{}{"name": "staging"},
+						"postchange_data": map[string]interface{}{"name": "prod"},
+					},
+				},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func testConflictDetectionResourceData(t *testing.T, lastUpdated string) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, Provider().ResourcesMap["netbox_tag"].Schema, map[string]interface{}{})
+	d.SetId("7")
+	d.Set(lastUpdatedKey, lastUpdated)
+	return d
+}
+
+func TestCheckConflict(t *testing.T) {
+	ts := newConflictTestServer(t, "2024-05-01T10:05:00.000000Z")
+	defer ts.Close()
+
+	for _, tt := range []struct {
+		mode     string
+		severity diag.Severity
+	}{
+		{conflictDetectionWarn, diag.Warning},
+		{conflictDetectionError, diag.Error},
+	} {
+		t.Run(tt.mode, func(t *testing.T) {
+			api := testMockProviderState(t, ts.URL)
+			api.conflictDetection = tt.mode
+
+			diags := checkConflict(testConflictDetectionResourceData(t, "2024-05-01T10:00:00.000000Z"), api, changeLoggedResources["netbox_tag"])
+			if assert.Len(t, diags, 1) {
+				assert.Equal(t, tt.severity, diags[0].Severity)
+				assert.Equal(t, "extras.tag 7 was changed outside of Terraform", diags[0].Summary)
+				assert.Contains(t, diags[0].Detail, "- 2024-05-01T10:05:00.000000Z by colleague: changed color and description")
+				assert.NotContains(t, diags[0].Detail, "by terraform")
+			}
+		})
+	}
+}
+
+func TestCheckConflict_unchanged(t *testing.T) {
+	ts := newConflictTestServer(t, "2024-05-01T10:00:00.000000Z")
+	defer ts.Close()
+
+	api := testMockProviderState(t, ts.URL)
+	api.conflictDetection = conflictDetectionError
+
+	diags := checkConflict(testConflictDetectionResourceData(t, "2024-05-01T10:00:00.000000Z"), api, changeLoggedResources["netbox_tag"])
+	assert.Empty(t, diags)
+
+	// without a recorded last_updated, there is nothing to compare with
+	diags = checkConflict(testConflictDetectionResourceData(t, ""), api, changeLoggedResources["netbox_tag"])
+	assert.Empty(t, diags)
+}
+
+func TestConflictDetectionBlocksUpdate(t *testing.T) {
+	ts := newConflictTestServer(t, "2024-05-01T10:05:00.000000Z")
+	defer ts.Close()
+
+	api := testMockProviderState(t, ts.URL)
+	api.conflictDetection = conflictDetectionError
+
+	// the tag update would hit the mock server and fail with a 404
+	diags := Provider().ResourcesMap["netbox_tag"].UpdateContext(context.Background(), testConflictDetectionResourceData(t, "2024-05-01T10:00:00.000000Z"), api)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, "extras.tag 7 was changed outside of Terraform", diags[0].Summary)
+	}
+}
+
+func TestChangeLoggedResourcesExist(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for name := range changeLoggedResources {
+		if assert.Contains(t, resources, name) {
+			assert.Contains(t, resources[name].Schema, lastUpdatedKey)
+		}
+	}
+}
+
+func TestChangedFields(t *testing.T) {
+	fields := changedFields(
+		json.RawMessage(`{"name": "a", "tags": [1], "comments": "x", "last_updated": "1"}`),
+		json.RawMessage(`{"name": "a", "tags": [1, 2], "description": "y", "last_updated": "2"}`),
+	)
+	assert.Equal(t, []string{"comments", "description", "tags"}, fields)
+}
+
+func TestConflictDetectionAfterRefresh(t *testing.T) {
+	// the tag was edited in the UI at 10:05, after Terraform updated it at 10:00
+	ts := newConflictTestServer(t, "2024-05-01T10:05:00.000000Z")
+	defer ts.Close()
+
+	api := testMockProviderState(t, ts.URL)
+	api.conflictDetection = conflictDetectionError
+
+	res := Provider().ResourcesMap["netbox_tag"]
+	d := testConflictDetectionResourceData(t, "2024-05-01T10:00:00.000000Z")
+
+	// the refresh must not absorb the change made outside of Terraform
+	diags := res.ReadContext(context.Background(), d, api)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "2024-05-01T10:00:00.000000Z", d.Get(lastUpdatedKey))
+
+	diags = res.UpdateContext(context.Background(), d, api)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Error, diags[0].Severity)
+		assert.Equal(t, "extras.tag 7 was changed outside of Terraform", diags[0].Summary)
+	}
+}
+
+func TestConflictDetectionImport(t *testing.T) {
+	ts := newConflictTestServer(t, "2024-05-01T10:05:00.000000Z")
+	defer ts.Close()
+
+	api := testMockProviderState(t, ts.URL)
+	api.conflictDetection = conflictDetectionError
+
+	// an imported object has no recorded last_updated yet, so the read records it
+	d := testConflictDetectionResourceData(t, "")
+	diags := Provider().ResourcesMap["netbox_tag"].ReadContext(context.Background(), d, api)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "2024-05-01T10:05:00.000000Z", d.Get(lastUpdatedKey))
+}
@@ -0,0 +1,36 @@
+package netbox
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// useContextCRUD replaces the legacy CRUD functions of a resource or data
+// source with their context aware variants, so that they can be wrapped
+// uniformly.
+func useContextCRUD(def *schema.Resource) {
+	if legacy := def.Create; legacy != nil {
+		def.CreateContext = legacyCRUDContext(legacy)
+		def.Create = nil
+	}
+	if legacy := def.Read; legacy != nil {
+		def.ReadContext = legacyCRUDContext(legacy)
+		def.Read = nil
+	}
+	if legacy := def.Update; legacy != nil {
+		def.UpdateContext = legacyCRUDContext(legacy)
+		def.Update = nil
+	}
+	if legacy := def.Delete; legacy != nil {
+		def.DeleteContext = legacyCRUDContext(legacy)
+		def.Delete = nil
+	}
+}
+
+func legacyCRUDContext(legacy func(*schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return diag.FromErr(legacy(d, m))
+	}
+}
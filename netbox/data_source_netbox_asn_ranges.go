@@ -0,0 +1,145 @@
+package netbox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var dataSourceNetboxAsnRangesFilters = []string{
+	"id", "name", "slug", "description", "start", "end",
+	"rir", "rir_id", "tenant", "tenant_id", "tenant_group", "tenant_group_id", "tag",
+}
+
+func dataSourceNetboxAsnRanges() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxAsnRangesRead,
+		Description: `:meta:subcategory:IP Address Management (IPAM):`,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter. Supported filters are " + joinStringWithFinalConjunction(dataSourceNetboxAsnRangesFilters, ", ", "and") + ", as well as their `__n` negations.",
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Default:          0,
+			},
+			"asn_ranges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rir_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"start": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"end": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchemaRead,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxAsnRangesRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	// Get user limit
+	var userLimit int64 = 0
+	if limitValue, ok := d.GetOk("limit"); ok {
+		userLimit = int64(limitValue.(int))
+	}
+
+	query := url.Values{}
+	if filter, ok := d.GetOk("filter"); ok {
+		var filterParams = filter.(*schema.Set)
+		for _, f := range filterParams.List() {
+			k := f.(map[string]interface{})["name"].(string)
+			v := f.(map[string]interface{})["value"].(string)
+			if !isSupportedRawFilter(dataSourceNetboxAsnRangesFilters, k) {
+				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+			}
+			query.Add(k, v)
+		}
+	}
+
+	asnRanges, err := netboxRawList[asnRange](api, "/ipam/asn-ranges/", query, userLimit)
+	if err != nil {
+		return err
+	}
+
+	if len(asnRanges) == 0 {
+		return errors.New("no result")
+	}
+
+	var s []map[string]interface{}
+	for _, v := range asnRanges {
+		var mapping = make(map[string]interface{})
+
+		mapping["id"] = v.ID
+		mapping["name"] = v.Name
+		mapping["slug"] = v.Slug
+		mapping["start"] = v.Start
+		mapping["end"] = v.End
+		mapping["description"] = v.Description
+		if v.Rir != nil {
+			mapping["rir_id"] = v.Rir.ID
+		}
+		if v.Tenant != nil {
+			mapping["tenant_id"] = v.Tenant.ID
+		}
+		mapping["tags"] = getTagListFromNestedTagList(v.Tags)
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(id.UniqueId())
+	return d.Set("asn_ranges", s)
+}
@@ -0,0 +1,64 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxAsnRangesDataSource_basic(t *testing.T) {
+	testSlug := "asn_ranges_ds"
+	testName := testAccGetTestName(testSlug)
+	setUp := fmt.Sprintf(`
+resource "netbox_rir" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_asn_range" "test_1" {
+  name   = "%[1]s_1"
+  rir_id = netbox_rir.test.id
+  start  = 4200002000
+  end    = 4200002099
+}
+
+resource "netbox_asn_range" "test_2" {
+  name   = "%[1]s_2"
+  rir_id = netbox_rir.test.id
+  start  = 4200002100
+  end    = 4200002199
+}`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: setUp,
+			},
+			{
+				Config: setUp + `
+data "netbox_asn_ranges" "by_rir" {
+  filter {
+    name  = "rir_id"
+    value = netbox_rir.test.id
+  }
+}
+
+data "netbox_asn_ranges" "by_name" {
+  filter {
+    name  = "name"
+    value = netbox_asn_range.test_2.name
+  }
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_asn_ranges.by_rir", "asn_ranges.#", "2"),
+					resource.TestCheckResourceAttr("data.netbox_asn_ranges.by_name", "asn_ranges.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_asn_ranges.by_name", "asn_ranges.0.id", "netbox_asn_range.test_2", "id"),
+					resource.TestCheckResourceAttr("data.netbox_asn_ranges.by_name", "asn_ranges.0.start", "4200002100"),
+					resource.TestCheckResourceAttr("data.netbox_asn_ranges.by_name", "asn_ranges.0.end", "4200002199"),
+					resource.TestCheckResourceAttrPair("data.netbox_asn_ranges.by_name", "asn_ranges.0.rir_id", "netbox_rir.test", "id"),
+				),
+			},
+		},
+	})
+}
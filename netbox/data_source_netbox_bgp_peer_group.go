@@ -0,0 +1,67 @@
+package netbox
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxBGPPeerGroup() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxBGPPeerGroupRead,
+		Description: `:meta:subcategory:Plugins:Looks up a peer group of the [netbox-bgp](https://github.com/netbox-community/netbox-bgp) plugin by name.`,
+		Schema: map[string]*schema.Schema{
+			"peer_group_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"import_policy_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"export_policy_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			tagsKey: tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxBGPPeerGroupRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	query := url.Values{}
+	query.Set("name", d.Get("name").(string))
+
+	group, err := netboxRawGetUnique[bgpPeerGroup](api, bgpPluginPath+"/peer-group/", query, "BGP peer group")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(group.ID, 10))
+	d.Set("peer_group_id", group.ID)
+	d.Set("name", group.Name)
+	d.Set("description", group.Description)
+	d.Set("import_policy_ids", getIDsFromRawNestedObjects(group.ImportPolicies))
+	d.Set("export_policy_ids", getIDsFromRawNestedObjects(group.ExportPolicies))
+	d.Set("comments", group.Comments)
+	d.Set(tagsKey, getTagListFromNestedTagList(group.Tags))
+
+	return nil
+}
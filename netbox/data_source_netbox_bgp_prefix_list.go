@@ -0,0 +1,105 @@
+package netbox
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxBGPPrefixList() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxBGPPrefixListRead,
+		Description: `:meta:subcategory:Plugins:Looks up a prefix list of the [netbox-bgp](https://github.com/netbox-community/netbox-bgp) plugin by name, including its rules.`,
+		Schema: map[string]*schema.Schema{
+			"prefix_list_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"family": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rules of the prefix list, sorted by their index.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prefix_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ge": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"le": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			tagsKey: tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxBGPPrefixListRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	query := url.Values{}
+	query.Set("name", d.Get("name").(string))
+
+	prefixList, err := netboxRawGetUnique[bgpPrefixList](api, bgpPluginPath+"/prefix-list/", query, "BGP prefix list")
+	if err != nil {
+		return err
+	}
+
+	rules, err := getBGPPrefixListRules(api, prefixList.ID)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(prefixList.ID, 10))
+	d.Set("prefix_list_id", prefixList.ID)
+	d.Set("name", prefixList.Name)
+	if prefixList.Family != nil {
+		d.Set("family", prefixList.Family.Value)
+	}
+	d.Set("description", prefixList.Description)
+	d.Set("comments", prefixList.Comments)
+	d.Set("rule", flattenBGPPrefixListRules(rules))
+	d.Set(tagsKey, getTagListFromNestedTagList(prefixList.Tags))
+
+	return nil
+}
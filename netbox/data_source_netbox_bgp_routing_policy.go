@@ -0,0 +1,60 @@
+package netbox
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxBGPRoutingPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxBGPRoutingPolicyRead,
+		Description: `:meta:subcategory:Plugins:Looks up a routing policy of the [netbox-bgp](https://github.com/netbox-community/netbox-bgp) plugin by name.`,
+		Schema: map[string]*schema.Schema{
+			"routing_policy_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"weight": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			tagsKey: tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxBGPRoutingPolicyRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	query := url.Values{}
+	query.Set("name", d.Get("name").(string))
+
+	policy, err := netboxRawGetUnique[bgpRoutingPolicy](api, bgpPluginPath+"/routing-policy/", query, "BGP routing policy")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(policy.ID, 10))
+	d.Set("routing_policy_id", policy.ID)
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("weight", policy.Weight)
+	d.Set("comments", policy.Comments)
+	d.Set(tagsKey, getTagListFromNestedTagList(policy.Tags))
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package netbox
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxBGPSession() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxBGPSessionRead,
+		Description: `:meta:subcategory:Plugins:Looks up a BGP session of the [netbox-bgp](https://github.com/netbox-community/netbox-bgp) plugin by name. If several devices have sessions with the same name, filter by ` + "`device_id`" + ` as well.`,
+		Schema: map[string]*schema.Schema{
+			"session_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"device_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"site_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"local_address_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"remote_address_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"local_as_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"remote_as_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"peer_group_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"import_policy_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"export_policy_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"prefix_list_in_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"prefix_list_out_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			tagsKey: tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxBGPSessionRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	query := url.Values{}
+	query.Set("name", d.Get("name").(string))
+	if deviceID, ok := d.GetOk("device_id"); ok {
+		query.Set("device_id", strconv.Itoa(deviceID.(int)))
+	}
+
+	session, err := netboxRawGetUnique[bgpSession](api, bgpPluginPath+"/session/", query, "BGP session")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(session.ID, 10))
+	d.Set("session_id", session.ID)
+	setBGPSessionAttributes(d, session)
+	d.Set(tagsKey, getTagListFromNestedTagList(session.Tags))
+
+	return nil
+}
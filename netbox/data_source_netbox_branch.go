@@ -0,0 +1,67 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxBranch() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxBranchRead,
+		Description: `:meta:subcategory:Plugins:Looks up a branch of the [netbox-branching](https://github.com/netboxlabs/netbox-branching) plugin by name.`,
+		Schema: map[string]*schema.Schema{
+			"branch_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schema_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The schema ID of the branch, as sent in the `X-NetBox-Branch` header.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_sync": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"merged_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			tagsKey: tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxBranchRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	b, err := getBranchByName(api, d.Get("name").(string))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(b.ID, 10))
+	d.Set("branch_id", b.ID)
+	d.Set("name", b.Name)
+	d.Set("description", b.Description)
+	d.Set("schema_id", b.SchemaID)
+	d.Set("status", b.status())
+	d.Set("last_sync", b.LastSync)
+	d.Set("merged_time", b.MergedTime)
+	d.Set(tagsKey, getTagListFromNestedTagList(b.Tags))
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type configTemplateRenderResponse struct {
+	Content string `json:"content"`
+}
+
+func dataSourceNetboxConfigTemplateRender() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxConfigTemplateRenderRead,
+		Description: `:meta:subcategory:Extras:Renders template code or a stored config template with the context of a device or virtual machine, e.g. to validate changes to a config template against real devices in CI before they are applied.
+
+With ` + "`template_code`" + `, exactly the given template code is rendered: it is stored in a temporary config template together with ` + "`environment_params`" + `, rendered and the temporary template is deleted again. If all arguments are known, this happens during plan, so errors in the template code fail the plan before the code is stored in a ` + "`netbox_config_template`" + `.
+
+With ` + "`config_template_id`" + `, the template code stored in NetBox under that ID at the time the data source is read is rendered, without writing to NetBox. If the ID is known during plan, that is the stored code before the apply, even if the config template is changed in the same run.
+
+Unlike ` + "`netbox_device_render_config`" + `, the device or virtual machine is passed to the template as its API representation, not as a database object. Attributes such as ` + "`device.name`" + ` or ` + "`device.site.slug`" + ` work, but related objects that are not part of the API representation (e.g. ` + "`device.interfaces.all()`" + `) are not available.`,
+		Schema: map[string]*schema.Schema{
+			"template_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"template_code", "config_template_id"},
+				Description:  "The Jinja2 template code to render through a temporary config template.",
+			},
+			"environment_params": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsJSON,
+				ConflictsWith: []string{"config_template_id"},
+				Description:   "Parameters of the Jinja2 environment of the temporary config template as JSON object, like on `netbox_config_template`.",
+			},
+			"config_template_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"template_code", "config_template_id"},
+				Description:  "The ID of a config template stored in NetBox to render.",
+			},
+			"device_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"virtual_machine_id"},
+				Description:   "The ID of the device to render the template for. The template can access the device as `device` and its config context data as top-level variables.",
+			},
+			"virtual_machine_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"device_id"},
+				Description:   "The ID of the virtual machine to render the template for. The template can access the virtual machine as `virtualmachine` and its config context data as top-level variables.",
+			},
+			"context": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				Description:  "Additional context data as JSON object. It takes precedence over the config context of the device or virtual machine.",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The rendered content.",
+			},
+		},
+	}
+}
+
+// getConfigTemplateRenderContext builds the template context from the object
+// the template is rendered for, mirroring the context NetBox uses when
+// rendering the config of a device or virtual machine.
+func getConfigTemplateRenderContext(api *providerState, d *schema.ResourceData) (map[string]any, error) {
+	renderContext := make(map[string]any)
+
+	var objectKey, objectPath string
+	if deviceID, ok := d.GetOk("device_id"); ok {
+		objectKey, objectPath = "device", fmt.Sprintf("/dcim/devices/%d/", deviceID.(int))
+	} else if vmID, ok := d.GetOk("virtual_machine_id"); ok {
+		objectKey, objectPath = "virtualmachine", fmt.Sprintf("/virtualization/virtual-machines/%d/", vmID.(int))
+	}
+
+	if objectPath != "" {
+		var object map[string]any
+		if err := netboxRawRequest(api, "GET", objectPath, nil, nil, &object); err != nil {
+			return nil, err
+		}
+		if configContext, ok := object["config_context"].(map[string]any); ok {
+			for k, v := range configContext {
+				renderContext[k] = v
+			}
+		}
+		renderContext[objectKey] = object
+	}
+
+	if contextJSON, ok := d.GetOk("context"); ok {
+		var extraContext map[string]any
+		if err := json.Unmarshal([]byte(contextJSON.(string)), &extraContext); err != nil {
+			return nil, fmt.Errorf("context must be a JSON object: %w", err)
+		}
+		for k, v := range extraContext {
+			renderContext[k] = v
+		}
+	}
+
+	return renderContext, nil
+}
+
+// dataSourceNetboxConfigTemplateRenderRead returns named diagnostics, so the
+// deletion of the temporary template can add its warning.
+func dataSourceNetboxConfigTemplateRenderRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	api := m.(*providerState)
+
+	renderContext, err := getConfigTemplateRenderContext(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	templateID := int64(d.Get("config_template_id").(int))
+	if templateCode, ok := d.GetOk("template_code"); ok {
+		var environmentParams any = map[string]any{}
+		if v, ok := d.GetOk("environment_params"); ok {
+			if err := json.Unmarshal([]byte(v.(string)), &environmentParams); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		name := "terraform-render-preview-" + id.UniqueId()
+		code := templateCode.(string)
+		data := models.WritableConfigTemplate{
+			Name:              &name,
+			Description:       "Temporary template created by Terraform to preview rendering",
+			TemplateCode:      &code,
+			EnvironmentParams: environmentParams,
+			Tags:              []*models.NestedTag{},
+		}
+
+		res, err := api.Extras.ExtrasConfigTemplatesCreate(extras.NewExtrasConfigTemplatesCreateParams().WithData(&data), nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		templateID = res.GetPayload().ID
+
+		defer func() {
+			if _, err := api.Extras.ExtrasConfigTemplatesDelete(extras.NewExtrasConfigTemplatesDeleteParams().WithID(templateID), nil); err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to delete temporary config template",
+					Detail:   fmt.Sprintf("The config template %q (ID %d) used for rendering could not be deleted and must be removed manually: %s", name, templateID, err),
+				})
+			}
+		}()
+	}
+
+	var rendered configTemplateRenderResponse
+	if err := netboxRawRequest(api, "POST", fmt.Sprintf("/extras/config-templates/%d/render/", templateID), nil, renderContext, &rendered); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to render template",
+			Detail:   getConfigTemplateRenderErrorDetail(err),
+		})
+	}
+
+	d.SetId(id.UniqueId())
+	d.Set("content", rendered.Content)
+
+	return diags
+}
+
+// getConfigTemplateRenderErrorDetail extracts the Jinja2 error message NetBox
+// returns when rendering fails.
+func getConfigTemplateRenderErrorDetail(err error) string {
+	if errresp, ok := err.(*netboxRawAPIError); ok {
+		var body struct {
+			Detail string `json:"detail"`
+		}
+		if json.Unmarshal([]byte(errresp.body), &body) == nil && body.Detail != "" {
+			return body.Detail
+		}
+	}
+	return err.Error()
+}
@@ -0,0 +1,192 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAccNetboxConfigTemplateRenderDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name   = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device_role" "test" {
+  name      = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_device" "test" {
+  name           = "%[1]s"
+  site_id        = netbox_site.test.id
+  device_type_id = netbox_device_type.test.id
+  role_id        = netbox_device_role.test.id
+}
+
+resource "netbox_config_context" "test" {
+  name  = "%[1]s"
+  data  = jsonencode({ ntp_server = "192.0.2.1" })
+  sites = [netbox_site.test.id]
+}
+`, testName)
+}
+
+func TestAccNetboxConfigTemplateRenderDataSource_basic(t *testing.T) {
+	testSlug := "cfg_tmpl_render"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxConfigTemplateRenderDependencies(testName) + `
+resource "netbox_config_template" "test" {
+  name          = "` + testName + `"
+  template_code = "hostname {{ device.name }}\nntp server {{ ntp_server }}\nlocation {{ location }}"
+}
+
+data "netbox_config_template_render" "test" {
+  config_template_id = netbox_config_template.test.id
+  device_id          = netbox_device.test.id
+  context            = jsonencode({ location = "rack 1" })
+  depends_on         = [netbox_config_context.test]
+}
+
+data "netbox_config_template_render" "code" {
+  template_code      = "hostname {{ device.name }}\n{% if ntp_server %}\nntp server {{ ntp_server }}\n{% endif %}"
+  environment_params = jsonencode({ trim_blocks = true })
+  device_id          = netbox_device.test.id
+  depends_on         = [netbox_config_context.test]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_config_template_render.test", "content", fmt.Sprintf("hostname %s\nntp server 192.0.2.1\nlocation rack 1", testName)),
+					resource.TestCheckResourceAttr("data.netbox_config_template_render.code", "content", fmt.Sprintf("hostname %s\nntp server 192.0.2.1\n", testName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxConfigTemplateRenderDataSource_templateError(t *testing.T) {
+	testSlug := "cfg_tmpl_render_err"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxConfigTemplateRenderDependencies(testName) + `
+data "netbox_config_template_render" "test" {
+  device_id     = netbox_device.test.id
+  template_code = "hostname {{ device.name }"
+}`,
+				ExpectError: regexp.MustCompile("Failed to render template"),
+			},
+		},
+	})
+}
+
+// newConfigTemplateRenderTestServer returns a mock NetBox that stores created
+// config templates as template 9 and records the requests made.
+func newConfigTemplateRenderTestServer(t *testing.T, renderStatus int, renderBody string) (*httptest.Server, *[]string, *map[string]interface{}) {
+	var requests []string
+	var created map[string]interface{}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/extras/config-templates/":
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Errorf("failed to decode config template: %v", err)
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": 9, "name": created["name"]})
+		case r.Method == "POST" && r.URL.Path == "/api/extras/config-templates/9/render/":
+			w.WriteHeader(renderStatus)
+			w.Write([]byte(renderBody))
+		case r.Method == "DELETE" && r.URL.Path == "/api/extras/config-templates/9/":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	return ts, &requests, &created
+}
+
+func TestDataSourceNetboxConfigTemplateRenderRead_templateCode(t *testing.T) {
+	ts, requests, created := newConfigTemplateRenderTestServer(t, http.StatusOK, `{"content": "hostname leaf01"}`)
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxConfigTemplateRender().Schema, map[string]interface{}{
+		"template_code":      "hostname {{ name }}",
+		"environment_params": `{"trim_blocks": true}`,
+		"context":            `{"name": "leaf01"}`,
+	})
+
+	diags := dataSourceNetboxConfigTemplateRenderRead(context.Background(), d, testMockProviderState(t, ts.URL))
+
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "hostname leaf01", d.Get("content"))
+	assert.Equal(t, "hostname {{ name }}", (*created)["template_code"])
+	assert.Equal(t, map[string]interface{}{"trim_blocks": true}, (*created)["environment_params"])
+	assert.Equal(t, []string{
+		"POST /api/extras/config-templates/",
+		"POST /api/extras/config-templates/9/render/",
+		"DELETE /api/extras/config-templates/9/",
+	}, *requests)
+}
+
+func TestDataSourceNetboxConfigTemplateRenderRead_templateCodeError(t *testing.T) {
+	ts, requests, _ := newConfigTemplateRenderTestServer(t, http.StatusBadRequest, `{"detail": "unexpected '}'"}`)
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxConfigTemplateRender().Schema, map[string]interface{}{
+		"template_code": "hostname {{ name }",
+	})
+
+	diags := dataSourceNetboxConfigTemplateRenderRead(context.Background(), d, testMockProviderState(t, ts.URL))
+
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, "Failed to render template", diags[0].Summary)
+		assert.Equal(t, "unexpected '}'", diags[0].Detail)
+	}
+	// The temporary template is deleted even if rendering fails
+	assert.Contains(t, *requests, "DELETE /api/extras/config-templates/9/")
+}
+
+func TestDataSourceNetboxConfigTemplateRenderRead_configTemplateID(t *testing.T) {
+	ts, requests, _ := newConfigTemplateRenderTestServer(t, http.StatusOK, `{"content": "hostname leaf01"}`)
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxConfigTemplateRender().Schema, map[string]interface{}{
+		"config_template_id": 9,
+	})
+
+	diags := dataSourceNetboxConfigTemplateRenderRead(context.Background(), d, testMockProviderState(t, ts.URL))
+
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "hostname leaf01", d.Get("content"))
+	// Stored templates are only rendered, never written
+	assert.Equal(t, []string{"POST /api/extras/config-templates/9/render/"}, *requests)
+}
@@ -0,0 +1,160 @@
+package netbox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var dataSourceNetboxContentTypesFilters = []string{"id", "app_label", "model", "q"}
+
+type rawObjectType struct {
+	ID       int64  `json:"id"`
+	AppLabel string `json:"app_label"`
+	Model    string `json:"model"`
+}
+
+// name returns the object type in the `<app_label>.<model>` form used
+// throughout the API, e.g. `dcim.device`.
+func (o rawObjectType) name() string {
+	return o.AppLabel + "." + o.Model
+}
+
+// listObjectTypes fetches the object types known to NetBox. The endpoint moved
+// from extras to core in NetBox 4.4, so the old location is tried as well.
+func listObjectTypes(api *providerState, query url.Values, userLimit int64) ([]rawObjectType, error) {
+	objectTypes, err := netboxRawList[rawObjectType](api, "/core/object-types/", query, userLimit)
+	var apiErr *netboxRawAPIError
+	if errors.As(err, &apiErr) && apiErr.Code() == 404 {
+		return netboxRawList[rawObjectType](api, "/extras/object-types/", query, userLimit)
+	}
+	return objectTypes, err
+}
+
+// getObjectTypeNames returns the names of all object types known to NetBox.
+// They are fetched once and cached for the lifetime of the provider.
+func getObjectTypeNames(api *providerState) ([]string, error) {
+	if api.objectTypes == nil {
+		api.objectTypes = &objectTypeNamesCache{}
+	}
+	cache := api.objectTypes
+	cache.once.Do(func() {
+		objectTypes, err := listObjectTypes(api, nil, 0)
+		if err != nil {
+			cache.err = err
+			return
+		}
+		for _, objectType := range objectTypes {
+			cache.names = append(cache.names, objectType.name())
+		}
+	})
+	return cache.names, cache.err
+}
+
+func dataSourceNetboxContentTypes() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxContentTypesRead,
+		Description: `:meta:subcategory:Extras:Lists the content types (object types) known to NetBox, e.g. to look up valid values for the ` + "`object_types`" + ` of permissions, custom fields or event rules.`,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter. Supported filters are " + joinStringWithFinalConjunction(dataSourceNetboxContentTypesFilters, ", ", "and") + ", as well as their `__n` negations.",
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Default:          0,
+			},
+			"content_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"app_label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"model": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The content type as used in the API, e.g. `dcim.device`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxContentTypesRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	// Get user limit
+	var userLimit int64 = 0
+	if limitValue, ok := d.GetOk("limit"); ok {
+		userLimit = int64(limitValue.(int))
+	}
+
+	query := url.Values{}
+	if filter, ok := d.GetOk("filter"); ok {
+		var filterParams = filter.(*schema.Set)
+		for _, f := range filterParams.List() {
+			k := f.(map[string]interface{})["name"].(string)
+			v := f.(map[string]interface{})["value"].(string)
+			if !isSupportedRawFilter(dataSourceNetboxContentTypesFilters, k) {
+				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+			}
+			query.Add(k, v)
+		}
+	}
+
+	objectTypes, err := listObjectTypes(api, query, userLimit)
+	if err != nil {
+		return err
+	}
+
+	if len(objectTypes) == 0 {
+		return errors.New("no result")
+	}
+
+	var s []map[string]interface{}
+	for _, v := range objectTypes {
+		var mapping = make(map[string]interface{})
+
+		mapping["id"] = v.ID
+		mapping["app_label"] = v.AppLabel
+		mapping["model"] = v.Model
+		mapping["name"] = v.name()
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(id.UniqueId())
+	return d.Set("content_types", s)
+}
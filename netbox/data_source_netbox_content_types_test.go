@@ -0,0 +1,45 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxContentTypesDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "netbox_content_types" "device" {
+  filter {
+    name  = "app_label"
+    value = "dcim"
+  }
+  filter {
+    name  = "model"
+    value = "device"
+  }
+}
+
+data "netbox_content_types" "dcim" {
+  filter {
+    name  = "app_label"
+    value = "dcim"
+  }
+  limit = 3
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_content_types.device", "content_types.#", "1"),
+					resource.TestCheckResourceAttrSet("data.netbox_content_types.device", "content_types.0.id"),
+					resource.TestCheckResourceAttr("data.netbox_content_types.device", "content_types.0.app_label", "dcim"),
+					resource.TestCheckResourceAttr("data.netbox_content_types.device", "content_types.0.model", "device"),
+					resource.TestCheckResourceAttr("data.netbox_content_types.device", "content_types.0.name", "dcim.device"),
+					resource.TestCheckResourceAttr("data.netbox_content_types.dcim", "content_types.#", "3"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,61 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxDataFile() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxDataFileRead,
+		Description: `:meta:subcategory:Extras:Looks up a data file synchronized from a data source.`,
+		Schema: map[string]*schema.Schema{
+			"source_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the data source the file belongs to.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the file within the data source.",
+			},
+			"data_file_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the file in bytes.",
+			},
+			"hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA256 hash of the file content.",
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetboxDataFileRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	dataFile, err := getDataFileByPath(api, int64(d.Get("source_id").(int)), d.Get("path").(string))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(dataFile.ID, 10))
+	d.Set("data_file_id", dataFile.ID)
+	d.Set("size", dataFile.Size)
+	d.Set("hash", dataFile.Hash)
+	d.Set("last_updated", dataFile.LastUpdated)
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package netbox
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDataFileTestServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/core/data-files/" {
+			http.NotFound(w, r)
+			return
+		}
+		var results []map[string]interface{}
+		if r.URL.Query().Get("source_id") == "3" && r.URL.Query().Get("path") == "templates/leaf.j2" {
+			results = append(results, map[string]interface{}{
+				"id":           21,
+				"source":       map[string]interface{}{"id": 3},
+				"path":         "templates/leaf.j2",
+				"last_updated": "2024-05-01T10:00:05Z",
+				"size":         512,
+				"hash":         "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"count": len(results), "results": results})
+	}))
+}
+
+func TestDataSourceNetboxDataFileRead(t *testing.T) {
+	ts := newDataFileTestServer(t)
+	defer ts.Close()
+	state := testMockProviderState(t, ts.URL)
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxDataFile().Schema, map[string]interface{}{
+		"source_id": 3,
+		"path":      "templates/leaf.j2",
+	})
+	if err := dataSourceNetboxDataFileRead(d, state); err != nil {
+		t.Fatalf("dataSourceNetboxDataFileRead returned error: %v", err)
+	}
+	assert.Equal(t, "21", d.Id())
+	assert.Equal(t, 512, d.Get("size"))
+	assert.Equal(t, "2024-05-01T10:00:05Z", d.Get("last_updated"))
+
+	d = schema.TestResourceDataRaw(t, dataSourceNetboxDataFile().Schema, map[string]interface{}{
+		"source_id": 3,
+		"path":      "templates/spine.j2",
+	})
+	err := dataSourceNetboxDataFileRead(d, state)
+	if assert.Error(t, err) {
+		assert.Equal(t, "no data file found matching filter", err.Error())
+	}
+}
+
+func TestGetSyncedDataOverrideFields(t *testing.T) {
+	ts := newDataFileTestServer(t)
+	defer ts.Close()
+	state := testMockProviderState(t, ts.URL)
+
+	d := schema.TestResourceDataRaw(t, resourceNetboxConfigTemplate().Schema, map[string]interface{}{
+		"name":              "leaf",
+		"data_source_id":    3,
+		"data_file_path":    "templates/leaf.j2",
+		"auto_sync_enabled": true,
+	})
+	fields, err := getSyncedDataOverrideFields(state, d)
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]any{"data_source": 3, "data_file": int64(21), "auto_sync_enabled": true}, fields)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceNetboxConfigTemplate().Schema, map[string]interface{}{
+		"name":          "leaf",
+		"template_code": "hostname {{ device.name }}",
+	})
+	fields, err = getSyncedDataOverrideFields(state, d)
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]any{"data_source": nil, "data_file": nil, "auto_sync_enabled": false}, fields)
+	}
+}
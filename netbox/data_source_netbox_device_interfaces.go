@@ -163,6 +163,68 @@ func dataSourceNetboxDeviceInterfaces() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"speed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"duplex": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"wwn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mark_connected": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"poe_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"poe_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rf_role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rf_channel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rf_channel_frequency": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"rf_channel_width": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"tx_power": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"wireless_lan_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"vrf_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vdc_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
 					},
 				},
 			},
@@ -315,6 +377,53 @@ func dataSourceNetboxDeviceInterfaceRead(d *schema.ResourceData, m interface{})
 			mapping["lag_device_interface_id"] = v.Lag.ID
 		}
 
+		if v.Speed != nil {
+			mapping["speed"] = *v.Speed
+		}
+		if v.Duplex != nil && v.Duplex.Value != nil {
+			mapping["duplex"] = *v.Duplex.Value
+		}
+		if v.Wwn != nil {
+			mapping["wwn"] = *v.Wwn
+		}
+		mapping["mark_connected"] = v.MarkConnected
+		if v.PoeMode != nil && v.PoeMode.Value != nil {
+			mapping["poe_mode"] = *v.PoeMode.Value
+		}
+		if v.PoeType != nil && v.PoeType.Value != nil {
+			mapping["poe_type"] = *v.PoeType.Value
+		}
+		if v.RfRole != nil && v.RfRole.Value != nil {
+			mapping["rf_role"] = *v.RfRole.Value
+		}
+		if v.RfChannel != nil && v.RfChannel.Value != nil {
+			mapping["rf_channel"] = *v.RfChannel.Value
+		}
+		if v.RfChannelFrequency != nil {
+			mapping["rf_channel_frequency"] = *v.RfChannelFrequency
+		}
+		if v.RfChannelWidth != nil {
+			mapping["rf_channel_width"] = *v.RfChannelWidth
+		}
+		if v.TxPower != nil {
+			mapping["tx_power"] = *v.TxPower
+		}
+		if v.Vrf != nil {
+			mapping["vrf_id"] = v.Vrf.ID
+		}
+
+		var wirelessLanIDs []int64
+		for _, wlan := range v.WirelessLans {
+			wirelessLanIDs = append(wirelessLanIDs, wlan.ID)
+		}
+		mapping["wireless_lan_ids"] = wirelessLanIDs
+
+		var vdcIDs []int64
+		for _, vdc := range v.Vdcs {
+			vdcIDs = append(vdcIDs, vdc.ID)
+		}
+		mapping["vdc_ids"] = vdcIDs
+
 		s = append(s, mapping)
 	}
 
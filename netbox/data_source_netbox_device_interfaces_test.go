@@ -91,6 +91,11 @@ data "netbox_device_interfaces" "by_lag_id" {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.netbox_device_interfaces.by_lag_id", "interfaces.#", "1"),
 					resource.TestCheckResourceAttrPair("data.netbox_device_interfaces.by_lag_id", "interfaces.0.lag_device_interface_id", "netbox_device_interface.lag", "id"),
+					resource.TestCheckResourceAttr("data.netbox_device_interfaces.by_lag_id", "interfaces.0.speed", "1000000"),
+					resource.TestCheckResourceAttr("data.netbox_device_interfaces.by_lag_id", "interfaces.0.duplex", "full"),
+					resource.TestCheckResourceAttr("data.netbox_device_interfaces.by_lag_id", "interfaces.0.poe_mode", "pse"),
+					resource.TestCheckResourceAttr("data.netbox_device_interfaces.by_lag_id", "interfaces.0.poe_type", "type1-ieee802.3af"),
+					resource.TestCheckResourceAttr("data.netbox_device_interfaces.by_lag_id", "interfaces.0.mark_connected", "true"),
 				),
 			},
 		},
@@ -136,6 +141,11 @@ resource "netbox_device_interface" "member" {
   device_id               = netbox_device.test.id
   type                    = "1000base-t"
   lag_device_interface_id = netbox_device_interface.lag.id
+  speed                   = 1000000
+  duplex                  = "full"
+  poe_mode                = "pse"
+  poe_type                = "type1-ieee802.3af"
+  mark_connected          = true
 }
 `, testName)
 }
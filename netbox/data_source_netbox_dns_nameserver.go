@@ -0,0 +1,57 @@
+package netbox
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxDNSNameserver() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxDNSNameserverRead,
+		Description: `:meta:subcategory:Plugins:Looks up a name server of the [netbox-dns](https://github.com/peteeckel/netbox-plugin-dns) plugin by name.`,
+		Schema: map[string]*schema.Schema{
+			"nameserver_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			tagsKey: tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxDNSNameserverRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	query := url.Values{}
+	query.Set("name", d.Get("name").(string))
+
+	nameserver, err := netboxRawGetUnique[dnsNameserver](api, dnsPluginPath+"/nameservers/", query, "DNS name server")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(nameserver.ID, 10))
+	d.Set("nameserver_id", nameserver.ID)
+	d.Set("name", nameserver.Name)
+	d.Set("description", nameserver.Description)
+	if nameserver.Tenant != nil {
+		d.Set("tenant_id", nameserver.Tenant.ID)
+	}
+	d.Set(tagsKey, getTagListFromNestedTagList(nameserver.Tags))
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package netbox
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxDNSRecord() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxDNSRecordRead,
+		Description: `:meta:subcategory:Plugins:Looks up a record of a zone of the [netbox-dns](https://github.com/peteeckel/netbox-plugin-dns) plugin by name. If several records have the same name, filter by ` + "`type`" + ` or ` + "`value`" + ` as well.`,
+		Schema: map[string]*schema.Schema{
+			"record_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"disable_ptr": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"managed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ptr_record_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			tagsKey: tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxDNSRecordRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	query := url.Values{}
+	query.Set("zone_id", strconv.Itoa(d.Get("zone_id").(int)))
+	query.Set("name", d.Get("name").(string))
+	if recordType, ok := d.GetOk("type"); ok {
+		query.Set("type", recordType.(string))
+	}
+	if value, ok := d.GetOk("value"); ok {
+		query.Set("value", value.(string))
+	}
+
+	record, err := netboxRawGetUnique[dnsRecord](api, dnsPluginPath+"/records/", query, "DNS record")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(record.ID, 10))
+	d.Set("record_id", record.ID)
+	setDNSRecordAttributes(d, record)
+	d.Set(tagsKey, getTagListFromNestedTagList(record.Tags))
+
+	return nil
+}
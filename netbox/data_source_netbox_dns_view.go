@@ -0,0 +1,62 @@
+package netbox
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxDNSView() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxDNSViewRead,
+		Description: `:meta:subcategory:Plugins:Looks up a view of the [netbox-dns](https://github.com/peteeckel/netbox-plugin-dns) plugin by name.`,
+		Schema: map[string]*schema.Schema{
+			"view_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_view": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			tagsKey: tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxDNSViewRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	query := url.Values{}
+	query.Set("name", d.Get("name").(string))
+
+	view, err := netboxRawGetUnique[dnsView](api, dnsPluginPath+"/views/", query, "DNS view")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(view.ID, 10))
+	d.Set("view_id", view.ID)
+	d.Set("name", view.Name)
+	d.Set("description", view.Description)
+	d.Set("default_view", view.DefaultView)
+	if view.Tenant != nil {
+		d.Set("tenant_id", view.Tenant.ID)
+	}
+	d.Set(tagsKey, getTagListFromNestedTagList(view.Tags))
+
+	return nil
+}
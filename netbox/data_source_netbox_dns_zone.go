@@ -0,0 +1,104 @@
+package netbox
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxDNSZone() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxDNSZoneRead,
+		Description: `:meta:subcategory:Plugins:Looks up a zone of the [netbox-dns](https://github.com/peteeckel/netbox-plugin-dns) plugin by name.`,
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"view_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Required if zones with the same name exist in several views.",
+			},
+			"nameservers": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"soa_mname_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"soa_rname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"soa_serial": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			tagsKey: tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxDNSZoneRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	query := url.Values{}
+	query.Set("name", d.Get("name").(string))
+	if viewID, ok := d.GetOk("view_id"); ok {
+		query.Set("view_id", strconv.Itoa(viewID.(int)))
+	}
+
+	zone, err := netboxRawGetUnique[dnsZone](api, dnsPluginPath+"/zones/", query, "DNS zone")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(zone.ID, 10))
+	d.Set("zone_id", zone.ID)
+	d.Set("name", zone.Name)
+	if zone.View != nil {
+		d.Set("view_id", zone.View.ID)
+	}
+	d.Set("nameservers", getIDsFromRawNestedObjects(zone.Nameservers))
+	if zone.Status != nil {
+		d.Set("status", zone.Status.Value)
+	}
+	d.Set("description", zone.Description)
+	d.Set("default_ttl", zone.DefaultTTL)
+	if zone.SOAMName != nil {
+		d.Set("soa_mname_id", zone.SOAMName.ID)
+	}
+	d.Set("soa_rname", zone.SOARName)
+	d.Set("soa_serial", zone.SOASerial)
+	if zone.Tenant != nil {
+		d.Set("tenant_id", zone.Tenant.ID)
+	}
+	d.Set(tagsKey, getTagListFromNestedTagList(zone.Tags))
+
+	return nil
+}
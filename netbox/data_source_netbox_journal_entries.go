@@ -0,0 +1,187 @@
+package netbox
+
+import (
+	"fmt"
+
+	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetboxJournalEntries() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxJournalEntriesRead,
+		Description: `:meta:subcategory:Extras:Entries are returned newest first. Unlike most list data sources, an empty result is not an error.`,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter. Supported filters are `assigned_object_type`, `assigned_object_id`, `kind`, `created_by`, `created_by_id`, `id` and `tag`, as well as the `__n` negations of `assigned_object_type`, `assigned_object_id`, `kind`, `created_by` and `created_by_id`.",
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Default:          0,
+			},
+			"journal_entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"assigned_object_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"assigned_object_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"kind": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comments": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_by": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tags": tagsSchemaRead,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxJournalEntriesRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	params := extras.NewExtrasJournalEntriesListParams()
+
+	// Get user limit
+	var userLimit int64 = 0
+	if limitValue, ok := d.GetOk("limit"); ok {
+		userLimit = int64(limitValue.(int))
+	}
+
+	if filter, ok := d.GetOk("filter"); ok {
+		var filterParams = filter.(*schema.Set)
+		var tags []string
+		for _, f := range filterParams.List() {
+			k := f.(map[string]interface{})["name"]
+			v := f.(map[string]interface{})["value"]
+			vString := v.(string)
+			switch k {
+			case "id":
+				params.ID = &vString
+			case "assigned_object_type":
+				params.AssignedObjectType = &vString
+			case "assigned_object_type__n":
+				params.AssignedObjectTypen = &vString
+			case "assigned_object_id":
+				params.AssignedObjectID = &vString
+			case "assigned_object_id__n":
+				params.AssignedObjectIDn = &vString
+			case "kind":
+				params.Kind = &vString
+			case "kind__n":
+				params.Kindn = &vString
+			case "created_by":
+				params.CreatedBy = &vString
+			case "created_by__n":
+				params.CreatedByn = &vString
+			case "created_by_id":
+				params.CreatedByID = &vString
+			case "created_by_id__n":
+				params.CreatedByIDn = &vString
+			case "tag":
+				tags = append(tags, vString)
+				params.Tag = tags
+			default:
+				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+			}
+		}
+	}
+
+	// Fetch all pages with pagination
+	paginationHelper := NewPaginationHelper(userLimit)
+	var allEntries []*models.JournalEntry
+
+	pageSize := paginationHelper.GetPageSize()
+	for {
+		currentOffset := paginationHelper.CurrentOffset()
+		params.Limit = &pageSize
+		params.Offset = &currentOffset
+
+		res, err := api.Extras.ExtrasJournalEntriesList(params, nil)
+		if err != nil {
+			return fmt.Errorf("failed to fetch journal entries at offset %d: %w", currentOffset, err)
+		}
+
+		payload := res.GetPayload()
+		allEntries = append(allEntries, payload.Results...)
+
+		if len(payload.Results) == 0 {
+			break
+		}
+
+		if !paginationHelper.ShouldContinuePaging(int64(len(allEntries)), payload.Next) {
+			break
+		}
+
+		paginationHelper.Advance(int64(len(payload.Results)))
+	}
+
+	// Trim to user limit if specified
+	trimmedCount := paginationHelper.TrimToLimit(len(allEntries))
+	filteredEntries := allEntries[:trimmedCount]
+
+	s := make([]map[string]interface{}, 0, len(filteredEntries))
+	for _, v := range filteredEntries {
+		var mapping = make(map[string]interface{})
+
+		mapping["id"] = v.ID
+		mapping["assigned_object_type"] = v.AssignedObjectType
+		mapping["assigned_object_id"] = v.AssignedObjectID
+		mapping["comments"] = v.Comments
+		if v.Kind != nil {
+			mapping["kind"] = v.Kind.Value
+		}
+		if v.Created != nil {
+			mapping["created"] = v.Created.String()
+		}
+		mapping["created_by"] = v.CreatedBy
+		mapping["tags"] = getTagListFromNestedTagList(v.Tags)
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(id.UniqueId())
+	return d.Set("journal_entries", s)
+}
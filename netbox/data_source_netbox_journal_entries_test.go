@@ -0,0 +1,85 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxJournalEntriesDataSource_basic(t *testing.T) {
+	testSlug := "journal_entries_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_journal_entry" "info" {
+  assigned_object_type = "dcim.site"
+  assigned_object_id   = netbox_site.test.id
+  comments             = "%[1]s info"
+  delete_on_destroy    = true
+}
+
+resource "netbox_journal_entry" "warning" {
+  assigned_object_type = "dcim.site"
+  assigned_object_id   = netbox_site.test.id
+  kind                 = "warning"
+  comments             = "%[1]s warning"
+  delete_on_destroy    = true
+}
+
+data "netbox_journal_entries" "all" {
+  filter {
+    name  = "assigned_object_type"
+    value = "dcim.site"
+  }
+  filter {
+    name  = "assigned_object_id"
+    value = netbox_site.test.id
+  }
+  depends_on = [netbox_journal_entry.info, netbox_journal_entry.warning]
+}
+
+data "netbox_journal_entries" "warning" {
+  filter {
+    name  = "assigned_object_id"
+    value = netbox_site.test.id
+  }
+  filter {
+    name  = "kind"
+    value = "warning"
+  }
+  depends_on = [netbox_journal_entry.info, netbox_journal_entry.warning]
+}
+
+data "netbox_journal_entries" "none" {
+  filter {
+    name  = "assigned_object_id"
+    value = netbox_site.test.id
+  }
+  filter {
+    name  = "kind"
+    value = "danger"
+  }
+  depends_on = [netbox_journal_entry.info, netbox_journal_entry.warning]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_journal_entries.all", "journal_entries.#", "2"),
+					resource.TestCheckResourceAttr("data.netbox_journal_entries.warning", "journal_entries.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_journal_entries.warning", "journal_entries.0.id", "netbox_journal_entry.warning", "id"),
+					resource.TestCheckResourceAttr("data.netbox_journal_entries.warning", "journal_entries.0.kind", "warning"),
+					resource.TestCheckResourceAttr("data.netbox_journal_entries.warning", "journal_entries.0.comments", testName+" warning"),
+					resource.TestCheckResourceAttr("data.netbox_journal_entries.warning", "journal_entries.0.assigned_object_type", "dcim.site"),
+					resource.TestCheckResourceAttr("data.netbox_journal_entries.none", "journal_entries.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,223 @@
+package netbox
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type objectChange struct {
+	ID                int64           `json:"id"`
+	Time              string          `json:"time"`
+	User              *rawNestedUser  `json:"user"`
+	UserName          string          `json:"user_name"`
+	RequestID         string          `json:"request_id"`
+	Action            *rawChoice      `json:"action"`
+	ChangedObjectType string          `json:"changed_object_type"`
+	ChangedObjectID   int64           `json:"changed_object_id"`
+	ObjectRepr        string          `json:"object_repr"`
+	PrechangeData     json.RawMessage `json:"prechange_data"`
+	PostchangeData    json.RawMessage `json:"postchange_data"`
+}
+
+type rawNestedUser struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+}
+
+// objectChangeData returns change data as JSON string, or an empty string if
+// there is no data, e.g. the prechange data of a created object.
+func objectChangeData(data json.RawMessage) string {
+	if len(data) == 0 || string(data) == "null" {
+		return ""
+	}
+	return string(data)
+}
+
+func dataSourceNetboxObjectChanges() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxObjectChangesRead,
+		Description: `:meta:subcategory:Extras:Get the change log entries of Netbox, e.g. to report all changes of a Terraform run by filtering for the user and the time window of the run. Unlike most list data sources, this returns an empty list if nothing matches.`,
+		Schema: map[string]*schema.Schema{
+			"request_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return changes made by the request with this ID. This is the request ID Netbox generates and shows in the change log, not the `request_id` of the provider.",
+			},
+			"user": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return changes made by the user with this username.",
+			},
+			"user_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Only return changes made by the user with this ID.",
+			},
+			"object_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return changes of objects of this type, in the form `<app_label>.<model>`, e.g. `dcim.site`.",
+			},
+			"object_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Only return changes of the object with this ID. Usually combined with `object_type`.",
+			},
+			"action": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"create", "update", "delete"}, false)),
+				Description:      "Only return changes with this action. One of `create`, `update` and `delete`.",
+			},
+			"time_after": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+				Description:      "Only return changes made at or after this time, in RFC 3339 format.",
+			},
+			"time_before": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+				Description:      "Only return changes made at or before this time, in RFC 3339 format.",
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Default:          0,
+			},
+			"object_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"user_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"object_repr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prechange_data": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The data of the object before the change as JSON. Empty for created objects.",
+						},
+						"postchange_data": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The data of the object after the change as JSON. Empty for deleted objects.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxObjectChangesRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	// Get user limit
+	var userLimit int64 = 0
+	if limitValue, ok := d.GetOk("limit"); ok {
+		userLimit = int64(limitValue.(int))
+	}
+
+	query := url.Values{}
+	if v, ok := d.GetOk("request_id"); ok {
+		query.Set("request_id", v.(string))
+	}
+	if v, ok := d.GetOk("user"); ok {
+		query.Set("user", v.(string))
+	}
+	if v, ok := d.GetOk("user_id"); ok {
+		query.Set("user_id", strconv.Itoa(v.(int)))
+	}
+	if v, ok := d.GetOk("object_type"); ok {
+		query.Set("changed_object_type", v.(string))
+	}
+	if v, ok := d.GetOk("object_id"); ok {
+		query.Set("changed_object_id", strconv.Itoa(v.(int)))
+	}
+	if v, ok := d.GetOk("action"); ok {
+		query.Set("action", v.(string))
+	}
+	// Pass the time window in UTC, the offset notation of RFC 3339 is not accepted by all Netbox versions
+	if v, ok := d.GetOk("time_after"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+		query.Set("time_after", t.UTC().Format("2006-01-02 15:04:05"))
+	}
+	if v, ok := d.GetOk("time_before"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+		query.Set("time_before", t.UTC().Format("2006-01-02 15:04:05"))
+	}
+	query.Set("ordering", "time")
+
+	changes, err := netboxRawList[objectChange](api, "/core/object-changes/", query, userLimit)
+	if err != nil {
+		return err
+	}
+
+	s := []map[string]interface{}{}
+	for _, v := range changes {
+		var mapping = make(map[string]interface{})
+
+		mapping["id"] = v.ID
+		mapping["time"] = v.Time
+		if v.User != nil {
+			mapping["user_id"] = v.User.ID
+		}
+		mapping["user_name"] = v.UserName
+		mapping["request_id"] = v.RequestID
+		if v.Action != nil {
+			mapping["action"] = v.Action.Value
+		}
+		mapping["object_type"] = v.ChangedObjectType
+		mapping["object_id"] = v.ChangedObjectID
+		mapping["object_repr"] = v.ObjectRepr
+		mapping["prechange_data"] = objectChangeData(v.PrechangeData)
+		mapping["postchange_data"] = objectChangeData(v.PostchangeData)
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(id.UniqueId())
+	return d.Set("object_changes", s)
+}
@@ -0,0 +1,100 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccNetboxObjectChangesDataSource_basic(t *testing.T) {
+	testSlug := "obj_changes_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+data "netbox_object_changes" "test" {
+  object_type = "dcim.site"
+  object_id   = netbox_site.test.id
+  action      = "create"
+  time_after  = "2020-01-01T00:00:00Z"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_object_changes.test", "object_changes.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_object_changes.test", "object_changes.0.action", "create"),
+					resource.TestCheckResourceAttr("data.netbox_object_changes.test", "object_changes.0.object_type", "dcim.site"),
+					resource.TestCheckResourceAttrPair("data.netbox_object_changes.test", "object_changes.0.object_id", "netbox_site.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_object_changes.test", "object_changes.0.object_repr", testName),
+					resource.TestCheckResourceAttr("data.netbox_object_changes.test", "object_changes.0.prechange_data", ""),
+					resource.TestCheckResourceAttrSet("data.netbox_object_changes.test", "object_changes.0.request_id"),
+					resource.TestCheckResourceAttrSet("data.netbox_object_changes.test", "object_changes.0.user_name"),
+					resource.TestMatchResourceAttr("data.netbox_object_changes.test", "object_changes.0.postchange_data", regexp.MustCompile(fmt.Sprintf(`"name": ?"%s"`, testName))),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+data "netbox_object_changes" "test" {
+  object_type = "dcim.site"
+  object_id   = netbox_site.test.id
+  action      = "delete"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_object_changes.test", "object_changes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceNetboxObjectChangesRead_query(t *testing.T) {
+	var query url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/core/object-changes/" {
+			http.NotFound(w, r)
+			return
+		}
+		query = r.URL.Query()
+		json.NewEncoder(w).Encode(map[string]interface{}{"count": 0, "results": []interface{}{}})
+	}))
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxObjectChanges().Schema, map[string]interface{}{
+		"user":        "terraform",
+		"object_type": "dcim.site",
+		"action":      "delete",
+		"time_after":  "2024-05-01T12:00:00+02:00",
+		"time_before": "2024-05-01T14:30:00Z",
+	})
+
+	err := dataSourceNetboxObjectChangesRead(d, testMockProviderState(t, ts.URL))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "terraform", query.Get("user"))
+	assert.Equal(t, "dcim.site", query.Get("changed_object_type"))
+	assert.Equal(t, "delete", query.Get("action"))
+	// The time window is a range filter, which only knows the _after and
+	// _before suffixes
+	assert.Equal(t, "2024-05-01 10:00:00", query.Get("time_after"))
+	assert.Equal(t, "2024-05-01 14:30:00", query.Get("time_before"))
+	assert.NotContains(t, query, "time__gte")
+	assert.NotContains(t, query, "time__lte")
+}
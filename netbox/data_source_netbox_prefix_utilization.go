@@ -0,0 +1,483 @@
+package netbox
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+	"sort"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetboxPrefixUtilization() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetboxPrefixUtilizationRead,
+		Description: `:meta:subcategory:IP Address Management (IPAM):Reports the utilization of a prefix the same way the Netbox UI calculates it, together with its available sub-ranges and child prefixes.
+
+Container prefixes are measured by the address space covered by child prefixes, all other prefixes by their child IP addresses and the IP ranges marked as utilized. This makes the data source suitable for ` + "`check`" + ` blocks that alert on pools running out of space.`,
+		Schema: map[string]*schema.Schema{
+			"prefix_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"child_depth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Only return child prefixes up to this many levels below the prefix. `1` returns direct children only. Only affects `child_prefixes`.",
+			},
+			"child_status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return child prefixes with this status. Only affects `child_prefixes`.",
+			},
+			"child_vrf_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Only return child prefixes in this VRF. If unset, Netbox's own rules apply: children in the VRF of the prefix, or in any VRF for container prefixes in the global table. Only affects `child_prefixes`.",
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vrf_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"is_pool": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"utilization": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Utilization of the prefix in percent, between 0 and 100.",
+			},
+			"total_addresses": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Number of usable addresses in the prefix. Returned as a decimal string because IPv6 prefixes can exceed the range of a number.",
+			},
+			"used_addresses": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Number of used addresses in the prefix. Returned as a decimal string because IPv6 prefixes can exceed the range of a number.",
+			},
+			"available_prefixes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Free sub-prefixes not covered by any child prefix, as returned by the `available-prefixes` endpoint.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"available_ip_ranges": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Contiguous ranges of addresses that are not used by any child IP address or utilized IP range, matching what the `available-ips` endpoint hands out. Empty for container prefixes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"child_prefix_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"child_prefixes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vrf_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"depth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Depth of the child prefix relative to the queried prefix. Direct children have a depth of `1`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// addrInterval is an inclusive range of addresses in their integer representation.
+type addrInterval struct {
+	start *big.Int
+	end   *big.Int
+}
+
+func (i addrInterval) size() *big.Int {
+	size := new(big.Int).Sub(i.end, i.start)
+	return size.Add(size, big.NewInt(1))
+}
+
+func dataSourceNetboxPrefixUtilizationRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id := int64(d.Get("prefix_id").(int))
+
+	res, err := api.Ipam.IpamPrefixesRead(ipam.NewIpamPrefixesReadParams().WithID(id), nil)
+	if err != nil {
+		return err
+	}
+	prefix := res.GetPayload()
+
+	network, err := netip.ParsePrefix(*prefix.Prefix)
+	if err != nil {
+		return fmt.Errorf("error parsing prefix %s: %w", *prefix.Prefix, err)
+	}
+	network = network.Masked()
+
+	status := ""
+	if prefix.Status != nil && prefix.Status.Value != nil {
+		status = *prefix.Status.Value
+	}
+	isContainer := status == "container"
+
+	// vrfFilter mirrors how Netbox selects child objects: same VRF as the prefix,
+	// any VRF for global container prefixes and the global table otherwise
+	var vrfFilter *string
+	switch {
+	case prefix.Vrf != nil:
+		vrfFilter = strToPtr(strconv.FormatInt(prefix.Vrf.ID, 10))
+	case !isContainer:
+		vrfFilter = strToPtr("null")
+	}
+
+	full := addrInterval{start: addrToBigInt(network.Addr()), end: addrToBigInt(lastAddr(network))}
+
+	availablePrefixes, err := getAvailablePrefixesForPrefix(api, id)
+	if err != nil {
+		return err
+	}
+
+	total := full.size()
+	used := new(big.Int)
+	var availableRanges []addrInterval
+
+	switch {
+	case prefix.MarkUtilized:
+		used.Set(total)
+	case isContainer:
+		used.Set(total)
+		for _, p := range availablePrefixes {
+			available, err := netip.ParsePrefix(p)
+			if err != nil {
+				return fmt.Errorf("error parsing available prefix %s: %w", p, err)
+			}
+			used.Sub(used, prefixSize(available))
+		}
+	default:
+		usedIntervals, err := getUsedIntervalsForPrefix(api, network, vrfFilter)
+		if err != nil {
+			return err
+		}
+		for _, interval := range usedIntervals {
+			used.Add(used, interval.size())
+		}
+
+		// Netbox never hands out the network and broadcast address of
+		// non-pool IPv4 prefixes and the subnet-router anycast address of IPv6 prefixes
+		usable := addrInterval{start: new(big.Int).Set(full.start), end: new(big.Int).Set(full.end)}
+		if !prefix.IsPool {
+			if network.Addr().Is4() && network.Bits() < 31 {
+				usable.start.Add(usable.start, big.NewInt(1))
+				usable.end.Sub(usable.end, big.NewInt(1))
+				total.Sub(total, big.NewInt(2))
+			} else if network.Addr().Is6() && network.Bits() < 127 {
+				usable.start.Add(usable.start, big.NewInt(1))
+			}
+		}
+		availableRanges = intervalGaps(usable, usedIntervals)
+	}
+
+	utilization := 0.0
+	if total.Sign() > 0 {
+		ratio := new(big.Float).Quo(new(big.Float).SetInt(new(big.Int).Mul(used, big.NewInt(100))), new(big.Float).SetInt(total))
+		utilization, _ = ratio.Float64()
+	}
+	if utilization > 100 {
+		utilization = 100
+	}
+
+	childPrefixes, err := getChildPrefixesForPrefix(api, d, prefix, vrfFilter)
+	if err != nil {
+		return err
+	}
+
+	var rangeList []map[string]interface{}
+	for _, r := range availableRanges {
+		rangeList = append(rangeList, map[string]interface{}{
+			"start_address": bigIntToAddr(r.start, network.Addr().Is4()).String(),
+			"end_address":   bigIntToAddr(r.end, network.Addr().Is4()).String(),
+			"size":          r.size().String(),
+		})
+	}
+
+	var childList []map[string]interface{}
+	for _, child := range childPrefixes {
+		mapping := map[string]interface{}{
+			"id":     child.ID,
+			"prefix": child.Prefix,
+			"depth":  child.Depth - prefix.Depth,
+		}
+		if child.Status != nil {
+			mapping["status"] = child.Status.Value
+		}
+		if child.Vrf != nil {
+			mapping["vrf_id"] = child.Vrf.ID
+		}
+		childList = append(childList, mapping)
+	}
+
+	d.SetId(strconv.FormatInt(prefix.ID, 10))
+	d.Set("prefix", prefix.Prefix)
+	d.Set("status", status)
+	if prefix.Vrf != nil {
+		d.Set("vrf_id", prefix.Vrf.ID)
+	} else {
+		d.Set("vrf_id", nil)
+	}
+	d.Set("is_pool", prefix.IsPool)
+	d.Set("utilization", utilization)
+	d.Set("total_addresses", total.String())
+	d.Set("used_addresses", used.String())
+	d.Set("available_prefixes", availablePrefixes)
+	d.Set("available_ip_ranges", rangeList)
+	d.Set("child_prefix_count", len(childList))
+	return d.Set("child_prefixes", childList)
+}
+
+func getAvailablePrefixesForPrefix(api *providerState, id int64) ([]string, error) {
+	params := ipam.NewIpamPrefixesAvailablePrefixesListParams().WithID(id)
+	res, err := api.Ipam.IpamPrefixesAvailablePrefixesList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixes := []string{}
+	for _, p := range res.GetPayload() {
+		prefixes = append(prefixes, p.Prefix)
+	}
+	return prefixes, nil
+}
+
+// getUsedIntervalsForPrefix returns the merged address intervals occupied by
+// child IP addresses and IP ranges marked as utilized.
+func getUsedIntervalsForPrefix(api *providerState, network netip.Prefix, vrfFilter *string) ([]addrInterval, error) {
+	var intervals []addrInterval
+
+	ipParams := ipam.NewIpamIPAddressesListParams()
+	ipParams.Parent = strToPtr(network.String())
+	ipParams.VrfID = vrfFilter
+
+	paginationHelper := NewPaginationHelper(FetchAll)
+	pageSize := paginationHelper.GetPageSize()
+	var count int64
+	for {
+		currentOffset := paginationHelper.CurrentOffset()
+		ipParams.Limit = &pageSize
+		ipParams.Offset = &currentOffset
+
+		res, err := api.Ipam.IpamIPAddressesList(ipParams, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch child ip addresses at offset %d: %w", currentOffset, err)
+		}
+		payload := res.GetPayload()
+		for _, ip := range payload.Results {
+			addr, err := netip.ParsePrefix(*ip.Address)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing ip address %s: %w", *ip.Address, err)
+			}
+			n := addrToBigInt(addr.Addr())
+			intervals = append(intervals, addrInterval{start: n, end: n})
+		}
+		count += int64(len(payload.Results))
+
+		if !paginationHelper.ShouldContinuePaging(count, payload.Next) {
+			break
+		}
+		paginationHelper.Advance(int64(len(payload.Results)))
+	}
+
+	// the ip range list has no parent filter, so select the contained ranges here
+	rangeParams := ipam.NewIpamIPRangesListParams()
+	rangeParams.MarkUtilized = strToPtr("true")
+	rangeParams.VrfID = vrfFilter
+
+	paginationHelper = NewPaginationHelper(FetchAll)
+	count = 0
+	for {
+		currentOffset := paginationHelper.CurrentOffset()
+		rangeParams.Limit = &pageSize
+		rangeParams.Offset = &currentOffset
+
+		res, err := api.Ipam.IpamIPRangesList(rangeParams, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch ip ranges at offset %d: %w", currentOffset, err)
+		}
+		payload := res.GetPayload()
+		for _, ipRange := range payload.Results {
+			start, err := netip.ParsePrefix(*ipRange.StartAddress)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing ip range start address %s: %w", *ipRange.StartAddress, err)
+			}
+			end, err := netip.ParsePrefix(*ipRange.EndAddress)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing ip range end address %s: %w", *ipRange.EndAddress, err)
+			}
+			if network.Contains(start.Addr()) && network.Contains(end.Addr()) {
+				intervals = append(intervals, addrInterval{start: addrToBigInt(start.Addr()), end: addrToBigInt(end.Addr())})
+			}
+		}
+		count += int64(len(payload.Results))
+
+		if !paginationHelper.ShouldContinuePaging(count, payload.Next) {
+			break
+		}
+		paginationHelper.Advance(int64(len(payload.Results)))
+	}
+
+	return mergeIntervals(intervals), nil
+}
+
+func getChildPrefixesForPrefix(api *providerState, d *schema.ResourceData, prefix *models.Prefix, vrfFilter *string) ([]*models.Prefix, error) {
+	params := ipam.NewIpamPrefixesListParams()
+	params.Within = prefix.Prefix
+	params.VrfID = vrfFilter
+
+	if vrfID, ok := d.GetOk("child_vrf_id"); ok {
+		params.VrfID = strToPtr(strconv.Itoa(vrfID.(int)))
+	}
+	if status, ok := d.GetOk("child_status"); ok {
+		params.Status = strToPtr(status.(string))
+	}
+	if depth, ok := d.GetOk("child_depth"); ok {
+		params.DepthLte = strToPtr(strconv.FormatInt(prefix.Depth+int64(depth.(int)), 10))
+	}
+
+	paginationHelper := NewPaginationHelper(FetchAll)
+	pageSize := paginationHelper.GetPageSize()
+	var children []*models.Prefix
+	for {
+		currentOffset := paginationHelper.CurrentOffset()
+		params.Limit = &pageSize
+		params.Offset = &currentOffset
+
+		res, err := api.Ipam.IpamPrefixesList(params, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch child prefixes at offset %d: %w", currentOffset, err)
+		}
+		payload := res.GetPayload()
+		children = append(children, payload.Results...)
+
+		if !paginationHelper.ShouldContinuePaging(int64(len(children)), payload.Next) {
+			break
+		}
+		paginationHelper.Advance(int64(len(payload.Results)))
+	}
+	return children, nil
+}
+
+func addrToBigInt(addr netip.Addr) *big.Int {
+	return new(big.Int).SetBytes(addr.AsSlice())
+}
+
+func bigIntToAddr(n *big.Int, is4 bool) netip.Addr {
+	if is4 {
+		return netip.AddrFrom4([4]byte(n.FillBytes(make([]byte, 4))))
+	}
+	return netip.AddrFrom16([16]byte(n.FillBytes(make([]byte, 16))))
+}
+
+func lastAddr(network netip.Prefix) netip.Addr {
+	last := addrToBigInt(network.Addr())
+	last.Add(last, prefixSize(network))
+	last.Sub(last, big.NewInt(1))
+	return bigIntToAddr(last, network.Addr().Is4())
+}
+
+func prefixSize(network netip.Prefix) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(network.Addr().BitLen()-network.Bits()))
+}
+
+// mergeIntervals sorts the given intervals and merges overlapping and adjacent ones.
+func mergeIntervals(intervals []addrInterval) []addrInterval {
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].start.Cmp(intervals[j].start) < 0
+	})
+
+	var merged []addrInterval
+	for _, interval := range intervals {
+		if len(merged) > 0 {
+			last := &merged[len(merged)-1]
+			next := new(big.Int).Add(last.end, big.NewInt(1))
+			if interval.start.Cmp(next) <= 0 {
+				if interval.end.Cmp(last.end) > 0 {
+					last.end = interval.end
+				}
+				continue
+			}
+		}
+		merged = append(merged, addrInterval{start: interval.start, end: interval.end})
+	}
+	return merged
+}
+
+// intervalGaps returns the parts of bounds not covered by the merged intervals in used.
+func intervalGaps(bounds addrInterval, used []addrInterval) []addrInterval {
+	var gaps []addrInterval
+	cursor := new(big.Int).Set(bounds.start)
+	for _, interval := range used {
+		if interval.end.Cmp(cursor) < 0 {
+			continue
+		}
+		if interval.start.Cmp(bounds.end) > 0 {
+			break
+		}
+		if interval.start.Cmp(cursor) > 0 {
+			gaps = append(gaps, addrInterval{start: cursor, end: new(big.Int).Sub(interval.start, big.NewInt(1))})
+		}
+		cursor = new(big.Int).Add(interval.end, big.NewInt(1))
+	}
+	if cursor.Cmp(bounds.end) <= 0 {
+		gaps = append(gaps, addrInterval{start: cursor, end: new(big.Int).Set(bounds.end)})
+	}
+	return gaps
+}
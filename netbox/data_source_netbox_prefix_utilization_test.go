@@ -0,0 +1,170 @@
+package netbox
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxPrefixUtilizationDataSource_basic(t *testing.T) {
+	testSlug := "prefix_util_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vrf" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_prefix" "test" {
+  prefix      = "10.20.30.0/29"
+  status      = "active"
+  vrf_id      = netbox_vrf.test.id
+  description = "%[1]s"
+}
+
+resource "netbox_ip_address" "test" {
+  count      = 3
+  ip_address = "10.20.30.${count.index + 1}/29"
+  status     = "active"
+  vrf_id     = netbox_vrf.test.id
+}
+
+data "netbox_prefix_utilization" "test" {
+  prefix_id  = netbox_prefix.test.id
+  depends_on = [netbox_ip_address.test]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.test", "prefix", "10.20.30.0/29"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.test", "total_addresses", "6"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.test", "used_addresses", "3"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.test", "utilization", "50"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.test", "available_ip_ranges.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.test", "available_ip_ranges.0.start_address", "10.20.30.4"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.test", "available_ip_ranges.0.end_address", "10.20.30.6"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.test", "available_ip_ranges.0.size", "3"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.test", "child_prefix_count", "0"),
+					resource.TestCheckResourceAttrPair("data.netbox_prefix_utilization.test", "vrf_id", "netbox_vrf.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxPrefixUtilizationDataSource_container(t *testing.T) {
+	testSlug := "prefix_util_ds_container"
+	testName := testAccGetTestName(testSlug)
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vrf" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_prefix" "parent" {
+  prefix = "10.20.40.0/24"
+  status = "container"
+  vrf_id = netbox_vrf.test.id
+}
+
+resource "netbox_prefix" "child" {
+  prefix = "10.20.40.0/25"
+  status = "container"
+  vrf_id = netbox_vrf.test.id
+}
+
+resource "netbox_prefix" "grandchild" {
+  prefix = "10.20.40.0/26"
+  status = "reserved"
+  vrf_id = netbox_vrf.test.id
+}
+
+data "netbox_prefix_utilization" "all" {
+  prefix_id  = netbox_prefix.parent.id
+  depends_on = [netbox_prefix.child, netbox_prefix.grandchild]
+}
+
+data "netbox_prefix_utilization" "direct" {
+  prefix_id   = netbox_prefix.parent.id
+  child_depth = 1
+  depends_on  = [netbox_prefix.child, netbox_prefix.grandchild]
+}
+
+data "netbox_prefix_utilization" "reserved" {
+  prefix_id    = netbox_prefix.parent.id
+  child_status = "reserved"
+  depends_on   = [netbox_prefix.child, netbox_prefix.grandchild]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.all", "status", "container"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.all", "total_addresses", "256"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.all", "used_addresses", "128"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.all", "utilization", "50"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.all", "available_prefixes.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.all", "available_prefixes.0", "10.20.40.128/25"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.all", "available_ip_ranges.#", "0"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.all", "child_prefix_count", "2"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.direct", "child_prefix_count", "1"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.direct", "child_prefixes.0.prefix", "10.20.40.0/25"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.direct", "child_prefixes.0.depth", "1"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.reserved", "child_prefix_count", "1"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.reserved", "child_prefixes.0.prefix", "10.20.40.0/26"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_utilization.reserved", "child_prefixes.0.depth", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestIntervalGaps(t *testing.T) {
+	interval := func(start, end int64) addrInterval {
+		return addrInterval{start: big.NewInt(start), end: big.NewInt(end)}
+	}
+
+	for _, tt := range []struct {
+		name     string
+		used     []addrInterval
+		expected []addrInterval
+	}{
+		{
+			name:     "Empty",
+			used:     nil,
+			expected: []addrInterval{interval(1, 10)},
+		},
+		{
+			name:     "FullyUsed",
+			used:     []addrInterval{interval(0, 11)},
+			expected: nil,
+		},
+		{
+			name:     "OverlappingAndAdjacent",
+			used:     []addrInterval{interval(5, 6), interval(2, 3), interval(4, 4), interval(6, 8)},
+			expected: []addrInterval{interval(1, 1), interval(9, 10)},
+		},
+		{
+			name:     "OutsideBounds",
+			used:     []addrInterval{interval(0, 0), interval(11, 12), interval(5, 5)},
+			expected: []addrInterval{interval(1, 4), interval(6, 10)},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := intervalGaps(interval(1, 10), mergeIntervals(tt.used))
+			if len(actual) != len(tt.expected) {
+				t.Fatalf("expected %d gaps, got %d", len(tt.expected), len(actual))
+			}
+			for i := range actual {
+				if actual[i].start.Cmp(tt.expected[i].start) != 0 || actual[i].end.Cmp(tt.expected[i].end) != 0 {
+					t.Fatalf("gap %d: expected %s-%s, got %s-%s", i, tt.expected[i].start, tt.expected[i].end, actual[i].start, actual[i].end)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package netbox
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// rawScript is a custom script as returned by the /extras/scripts/ endpoint.
+type rawScript struct {
+	ID           int64             `json:"id"`
+	Name         string            `json:"name"`
+	Display      string            `json:"display"`
+	Description  string            `json:"description"`
+	Vars         map[string]string `json:"vars"`
+	IsExecutable bool              `json:"is_executable"`
+}
+
+// getScriptByQualifiedName looks up a script by `<module>.<class name>`, the
+// same notation NetBox accepts in place of the script ID in the API.
+func getScriptByQualifiedName(api *providerState, qualifiedName string) (*rawScript, error) {
+	if !strings.Contains(qualifiedName, ".") {
+		return nil, fmt.Errorf("script name %q must be in the format <module>.<class name>", qualifiedName)
+	}
+
+	var script rawScript
+	err := netboxRawRequest(api, "GET", "/extras/scripts/"+url.PathEscape(qualifiedName)+"/", nil, nil, &script)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok && errresp.Code() == 404 {
+			return nil, &objectNameNotFoundError{objectName: "script", name: qualifiedName}
+		}
+		return nil, err
+	}
+	return &script, nil
+}
+
+func dataSourceNetboxScript() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetboxScriptRead,
+		Description: `:meta:subcategory:Extras:Looks up a [custom script](https://netboxlabs.com/docs/netbox/customization/custom-scripts/) by its module and class name.
+
+Script IDs change whenever a script module is uploaded again, so this data source is the recommended way to reference a script in ` + "`netbox_script_run`" + `.`,
+		Schema: map[string]*schema.Schema{
+			"module": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the script module, i.e. the file name without the `.py` extension.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The class name of the script.",
+			},
+			"script_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"display": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_executable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "False if the script module can no longer be loaded by NetBox.",
+			},
+			"vars": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The variables of the script, mapping each variable name to its type, e.g. `ObjectVar`.",
+			},
+		},
+	}
+}
+
+func dataSourceNetboxScriptRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	module := d.Get("module").(string)
+	name := d.Get("name").(string)
+	script, err := getScriptByQualifiedName(api, module+"."+name)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(script.ID, 10))
+	d.Set("script_id", script.ID)
+	d.Set("display", script.Display)
+	d.Set("description", script.Description)
+	d.Set("is_executable", script.IsExecutable)
+	d.Set("vars", script.Vars)
+
+	return nil
+}
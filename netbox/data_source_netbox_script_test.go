@@ -0,0 +1,61 @@
+package netbox
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceNetboxScriptRead(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/extras/scripts/build_rack.BuildRack/" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":            15,
+			"module":        3,
+			"name":          "BuildRack",
+			"display":       "Build a rack",
+			"description":   "Creates a rack with its patch panels",
+			"vars":          map[string]string{"site": "ObjectVar", "rack_name": "StringVar"},
+			"is_executable": true,
+		})
+	}))
+	defer ts.Close()
+
+	cfg := Config{APIToken: "test-token", ServerURL: ts.URL}
+	client, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	state := &providerState{NetBoxAPI: client}
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxScript().Schema, map[string]interface{}{
+		"module": "build_rack",
+		"name":   "BuildRack",
+	})
+	if err := dataSourceNetboxScriptRead(d, state); err != nil {
+		t.Fatalf("dataSourceNetboxScriptRead returned error: %v", err)
+	}
+
+	assert.Equal(t, "15", d.Id())
+	assert.Equal(t, 15, d.Get("script_id"))
+	assert.Equal(t, "Build a rack", d.Get("display"))
+	assert.Equal(t, true, d.Get("is_executable"))
+	assert.Equal(t, map[string]interface{}{"site": "ObjectVar", "rack_name": "StringVar"}, d.Get("vars"))
+
+	d = schema.TestResourceDataRaw(t, dataSourceNetboxScript().Schema, map[string]interface{}{
+		"module": "build_rack",
+		"name":   "Missing",
+	})
+	err = dataSourceNetboxScriptRead(d, state)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `no script found with name "build_rack.Missing"`)
+	}
+}
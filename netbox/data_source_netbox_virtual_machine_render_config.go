@@ -0,0 +1,67 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type renderConfigResponse struct {
+	ConfigTemplate *struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"configtemplate"`
+	Content string `json:"content"`
+}
+
+func dataSourceNetboxVirtualMachineRenderConfig() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxVirtualMachineRenderConfigRead,
+		Description: `:meta:subcategory:Virtualization:Render the configuration template assigned to a virtual machine using the virtual machine's config context.`,
+		Schema: map[string]*schema.Schema{
+			"virtual_machine_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the virtual machine to render configuration for.",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The rendered configuration content.",
+			},
+			"config_template_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the config template that was used for rendering.",
+			},
+			"config_template_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the config template that was used for rendering.",
+			},
+		},
+	}
+}
+
+func dataSourceNetboxVirtualMachineRenderConfigRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	vmID := int64(d.Get("virtual_machine_id").(int))
+
+	var result renderConfigResponse
+	err := netboxRawRequest(api, "POST", fmt.Sprintf("/virtualization/virtual-machines/%d/render-config/", vmID), nil, map[string]any{}, &result)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(vmID, 10))
+	d.Set("content", result.Content)
+
+	if result.ConfigTemplate != nil {
+		d.Set("config_template_id", result.ConfigTemplate.ID)
+		d.Set("config_template_name", result.ConfigTemplate.Name)
+	}
+
+	return nil
+}
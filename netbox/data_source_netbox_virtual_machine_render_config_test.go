@@ -0,0 +1,42 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxVirtualMachineRenderConfigDataSource_basic(t *testing.T) {
+	testSlug := "vm_render_cfg"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxVirtualMachineSiteClusterDependencies(testName) + fmt.Sprintf(`
+resource "netbox_config_template" "test" {
+  name = "%[1]s"
+  template_code = "hostname={{ virtualmachine.name }}"
+}
+
+resource "netbox_virtual_machine" "test" {
+  name = "%[1]s"
+  site_id = netbox_site.test.id
+  config_template_id = netbox_config_template.test.id
+}
+
+data "netbox_virtual_machine_render_config" "test" {
+  depends_on = [netbox_virtual_machine.test]
+  virtual_machine_id = netbox_virtual_machine.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_virtual_machine_render_config.test", "id", "netbox_virtual_machine.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_virtual_machine_render_config.test", "content", fmt.Sprintf("hostname=%s", testName)),
+					resource.TestCheckResourceAttrPair("data.netbox_virtual_machine_render_config.test", "config_template_id", "netbox_config_template.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_virtual_machine_render_config.test", "config_template_name", testName),
+				),
+			},
+		},
+	})
+}
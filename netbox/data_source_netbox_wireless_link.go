@@ -0,0 +1,152 @@
+package netbox
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/wireless"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxWirelessLink() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxWirelessLinkRead,
+		Description: `:meta:subcategory:Wireless:`,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "ssid", "interface_a_id", "interface_b_id"},
+			},
+			"ssid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "ssid", "interface_a_id", "interface_b_id"},
+			},
+			"interface_a_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "ssid", "interface_a_id", "interface_b_id"},
+			},
+			"interface_b_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "ssid", "interface_a_id", "interface_b_id"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"auth_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_cipher": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_psk": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"distance": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"distance_unit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			tagsKey: tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxWirelessLinkRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	params := wireless.NewWirelessWirelessLinksListParams()
+
+	params.Limit = int64ToPtr(2)
+	if id, ok := d.Get("id").(string); ok && id != "" {
+		params.SetID(&id)
+	}
+	if ssid, ok := d.Get("ssid").(string); ok && ssid != "" {
+		params.SetSsid(&ssid)
+	}
+	if interfaceA, ok := d.Get("interface_a_id").(int); ok && interfaceA != 0 {
+		params.SetInterfaceaID(strToPtr(strconv.Itoa(interfaceA)))
+	}
+	if interfaceB, ok := d.Get("interface_b_id").(int); ok && interfaceB != 0 {
+		params.SetInterfacebID(strToPtr(strconv.Itoa(interfaceB)))
+	}
+
+	var rawResults struct {
+		Results []wirelessLinkDistanceFields `json:"results"`
+	}
+	res, err := api.Wireless.WirelessWirelessLinksList(params, nil, withRawResponse(&rawResults))
+	if err != nil {
+		return err
+	}
+
+	if *res.GetPayload().Count > int64(1) {
+		return errors.New("more than one wireless link returned, specify a more narrow filter")
+	}
+	if *res.GetPayload().Count == int64(0) {
+		return errors.New("no wireless link found matching filter")
+	}
+
+	link := res.GetPayload().Results[0]
+
+	d.SetId(strconv.FormatInt(link.ID, 10))
+	d.Set("ssid", link.Ssid)
+	d.Set("description", link.Description)
+	d.Set("comments", link.Comments)
+	d.Set("auth_psk", link.AuthPsk)
+	d.Set(tagsKey, getTagListFromNestedTagList(link.Tags))
+
+	if link.Interfacea != nil {
+		d.Set("interface_a_id", link.Interfacea.ID)
+	}
+	if link.Interfaceb != nil {
+		d.Set("interface_b_id", link.Interfaceb.ID)
+	}
+	if link.Status != nil {
+		d.Set("status", link.Status.Value)
+	}
+	if link.AuthType != nil {
+		d.Set("auth_type", link.AuthType.Value)
+	}
+	if link.AuthCipher != nil {
+		d.Set("auth_cipher", link.AuthCipher.Value)
+	}
+	if link.Tenant != nil {
+		d.Set("tenant_id", link.Tenant.ID)
+	}
+
+	if len(rawResults.Results) > 0 {
+		d.Set("distance", rawResults.Results[0].Distance)
+		if rawResults.Results[0].DistanceUnit != nil {
+			d.Set("distance_unit", rawResults.Results[0].DistanceUnit.Value)
+		}
+	}
+
+	return nil
+}
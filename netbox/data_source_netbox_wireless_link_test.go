@@ -0,0 +1,49 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxWirelessLinkDataSource_basic(t *testing.T) {
+	testSlug := "wlink_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	setUp := testAccNetboxWirelessLinkDependencies(testName) + fmt.Sprintf(`
+resource "netbox_wireless_link" "test" {
+  interface_a_id = netbox_device_interface.a.id
+  interface_b_id = netbox_device_interface.b.id
+  ssid           = "%[1]s"
+  distance       = 300
+  distance_unit  = "m"
+  tags           = [netbox_tag.test.name]
+}`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: setUp + `
+data "netbox_wireless_link" "by_id" {
+  id = netbox_wireless_link.test.id
+}
+
+data "netbox_wireless_link" "by_interface" {
+  interface_a_id = netbox_device_interface.a.id
+  depends_on     = [netbox_wireless_link.test]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_wireless_link.by_id", "id", "netbox_wireless_link.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_wireless_link.by_id", "ssid", testName),
+					resource.TestCheckResourceAttr("data.netbox_wireless_link.by_id", "status", "connected"),
+					resource.TestCheckResourceAttr("data.netbox_wireless_link.by_id", "distance", "300"),
+					resource.TestCheckResourceAttr("data.netbox_wireless_link.by_id", "distance_unit", "m"),
+					resource.TestCheckResourceAttr("data.netbox_wireless_link.by_id", "tags.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_wireless_link.by_id", "interface_b_id", "netbox_device_interface.b", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_wireless_link.by_interface", "id", "netbox_wireless_link.test", "id"),
+				),
+			},
+		},
+	})
+}
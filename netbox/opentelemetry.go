@@ -0,0 +1,206 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/e-breuninger/terraform-provider-netbox/netbox"
+
+// openTelemetry holds the tracer provider of the process. It is set up by the
+// first provider instance that is configured, from the standard OTEL_*
+// environment variables. Tracing is off unless OTEL_TRACES_EXPORTER is set.
+var openTelemetry struct {
+	once     sync.Once
+	enabled  atomic.Bool
+	provider *sdktrace.TracerProvider
+	// span context of the TRACEPARENT environment variable, e.g. set by a CI
+	// pipeline, that all spans of the provider are children of
+	parent trace.SpanContext
+	err    error
+}
+
+func setupOpenTelemetry() error {
+	openTelemetry.once.Do(func() {
+		provider, err := newTracerProvider()
+		if err != nil || provider == nil {
+			openTelemetry.err = err
+			return
+		}
+
+		otel.SetTracerProvider(provider)
+		openTelemetry.provider = provider
+		openTelemetry.parent = trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), propagation.MapCarrier{
+			"traceparent": os.Getenv("TRACEPARENT"),
+			"tracestate":  os.Getenv("TRACESTATE"),
+		}))
+		openTelemetry.enabled.Store(true)
+	})
+	return openTelemetry.err
+}
+
+// ShutdownOpenTelemetry exports the remaining spans. It must be called before
+// the provider process exits.
+func ShutdownOpenTelemetry(ctx context.Context) error {
+	if !openTelemetry.enabled.Load() {
+		return nil
+	}
+	return openTelemetry.provider.Shutdown(ctx)
+}
+
+// newTracerProvider returns the tracer provider for the exporter selected by
+// OTEL_TRACES_EXPORTER, or nil if tracing is off. Besides the otlp exporter,
+// the console exporter writes the spans as JSON to stdout, or to the file in
+// NETBOX_OTEL_TRACES_FILE, so that traces can be inspected without a
+// collector.
+func newTracerProvider() (*sdktrace.TracerProvider, error) {
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") {
+		return nil, nil
+	}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch name := os.Getenv("OTEL_TRACES_EXPORTER"); name {
+	case "", "none":
+		return nil, nil
+	case "otlp":
+		protocol := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL")
+		if protocol == "" {
+			protocol = os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+		}
+		if protocol != "" && protocol != "http/protobuf" {
+			return nil, fmt.Errorf("unsupported OTLP protocol %q, only http/protobuf is supported", protocol)
+		}
+		exporter, err = otlptracehttp.New(context.Background())
+	case "console":
+		var w io.Writer = os.Stdout
+		if path := os.Getenv("NETBOX_OTEL_TRACES_FILE"); path != "" {
+			w, err = os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+			if err != nil {
+				return nil, fmt.Errorf("error opening OpenTelemetry traces file: %w", err)
+			}
+		}
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(w))
+	default:
+		return nil, fmt.Errorf("unsupported OTEL_TRACES_EXPORTER %q, supported are otlp, console and none", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelemetry exporter: %w", err)
+	}
+
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence
+	res, err := resource.New(context.Background(),
+		resource.WithAttributes(attribute.String("service.name", "terraform-provider-netbox")),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelemetry resource: %w", err)
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	), nil
+}
+
+// addOpenTelemetrySpans wraps the CRUD functions of a resource or data source
+// to record a span per operation. The requests of the operation are recorded
+// as child spans by the tracingTransport.
+func addOpenTelemetrySpans(def *schema.Resource, spanPrefix, resourceType string) {
+	useContextCRUD(def)
+
+	attributes := []attribute.KeyValue{attribute.String("terraform.resource_type", resourceType)}
+	if object, ok := changeLoggedResources[resourceType]; ok {
+		attributes = append(attributes, attribute.String("netbox.object_type", object.objectType))
+	}
+
+	if def.CreateContext != nil {
+		def.CreateContext = withOperationSpan(spanPrefix+".Create", attributes, def.CreateContext)
+	}
+	if def.ReadContext != nil {
+		def.ReadContext = withOperationSpan(spanPrefix+".Read", attributes, def.ReadContext)
+	}
+	if def.UpdateContext != nil {
+		def.UpdateContext = withOperationSpan(spanPrefix+".Update", attributes, def.UpdateContext)
+	}
+	if def.DeleteContext != nil {
+		def.DeleteContext = withOperationSpan(spanPrefix+".Delete", attributes, def.DeleteContext)
+	}
+}
+
+func withOperationSpan(name string, attributes []attribute.KeyValue, operation func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		api, ok := m.(*providerState)
+		if !ok || !openTelemetry.enabled.Load() {
+			return operation(ctx, d, m)
+		}
+
+		if openTelemetry.parent.IsValid() {
+			ctx = trace.ContextWithRemoteSpanContext(ctx, openTelemetry.parent)
+		}
+		ctx, span := otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+		defer span.End()
+
+		// The ID is gone after a delete and only known after a create
+		if d.Id() != "" {
+			span.SetAttributes(attribute.String("netbox.object_id", d.Id()))
+		}
+		diags := operation(ctx, d, api.withTraceContext(ctx))
+		if d.Id() != "" {
+			span.SetAttributes(attribute.String("netbox.object_id", d.Id()))
+		}
+
+		for _, diagnostic := range diags {
+			if diagnostic.Severity == diag.Error {
+				span.SetStatus(codes.Error, diagnostic.Summary)
+				break
+			}
+		}
+		return diags
+	}
+}
+
+// withTraceContext returns a copy of the state whose requests are recorded as
+// children of the span in ctx. The API clients of go-netbox create their own
+// contexts, so the span is passed on by the transport.
+func (s *providerState) withTraceContext(ctx context.Context) *providerState {
+	traced := *s
+	traced.NetBoxAPI = client.New(traceContextTransport{original: s.Transport, span: trace.SpanFromContext(ctx)}, nil)
+	return &traced
+}
+
+// traceContextTransport adds a span to the context of every operation.
+type traceContextTransport struct {
+	original runtime.ClientTransport
+	span     trace.Span
+}
+
+func (t traceContextTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	traced := *op
+	ctx := op.Context //nolint:staticcheck // go-netbox passes the context of the params here
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	traced.Context = trace.ContextWithSpan(ctx, t.span)
+	return t.original.Submit(&traced)
+}
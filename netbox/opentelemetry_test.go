@@ -0,0 +1,113 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// testOpenTelemetry records the spans of the test in memory.
+func testOpenTelemetry(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	openTelemetry.enabled.Store(true)
+	t.Cleanup(func() {
+		openTelemetry.enabled.Store(false)
+		otel.SetTracerProvider(previous)
+	})
+	return exporter
+}
+
+func spanAttributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attributes := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes {
+		attributes[kv.Key] = kv.Value
+	}
+	return attributes
+}
+
+func TestOpenTelemetrySpans(t *testing.T) {
+	exporter := testOpenTelemetry(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/extras/tags/7/" {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": 7, "name": "prod", "slug": "prod", "color": "ff0000"})
+	}))
+	defer ts.Close()
+
+	res := Provider().ResourcesMap["netbox_tag"]
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+	d.SetId("7")
+
+	diags := res.ReadContext(context.Background(), d, testMockProviderState(t, ts.URL))
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "prod", d.Get("name"))
+
+	spans := exporter.GetSpans()
+	if !assert.Len(t, spans, 2) {
+		return
+	}
+	request, operation := spans[0], spans[1]
+
+	assert.Equal(t, "netbox_tag.Read", operation.Name)
+	attributes := spanAttributes(operation)
+	assert.Equal(t, "netbox_tag", attributes["terraform.resource_type"].AsString())
+	assert.Equal(t, "extras.tag", attributes["netbox.object_type"].AsString())
+	assert.Equal(t, "7", attributes["netbox.object_id"].AsString())
+
+	assert.Equal(t, "HTTP GET", request.Name)
+	assert.Equal(t, operation.SpanContext.SpanID(), request.Parent.SpanID())
+	attributes = spanAttributes(request)
+	assert.Equal(t, "/api/extras/tags/7/", attributes["url.path"].AsString())
+	assert.Equal(t, int64(200), attributes["http.response.status_code"].AsInt64())
+}
+
+func TestOpenTelemetryDisabled(t *testing.T) {
+	t.Setenv("OTEL_TRACES_EXPORTER", "")
+	provider, err := newTracerProvider()
+	assert.NoError(t, err)
+	assert.Nil(t, provider)
+
+	t.Setenv("OTEL_TRACES_EXPORTER", "zipkin")
+	_, err = newTracerProvider()
+	assert.ErrorContains(t, err, `unsupported OTEL_TRACES_EXPORTER "zipkin"`)
+}
+
+func TestOpenTelemetryConsoleExporter(t *testing.T) {
+	tracesFile := filepath.Join(t.TempDir(), "traces.json")
+	t.Setenv("OTEL_TRACES_EXPORTER", "console")
+	t.Setenv("NETBOX_OTEL_TRACES_FILE", tracesFile)
+	t.Setenv("OTEL_SERVICE_NAME", "netbox-ci")
+
+	provider, err := newTracerProvider()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, span := provider.Tracer(tracerName).Start(context.Background(), "netbox_site.Create")
+	span.End()
+	assert.NoError(t, provider.Shutdown(context.Background()))
+
+	traces, err := os.ReadFile(tracesFile)
+	assert.NoError(t, err)
+	assert.Contains(t, string(traces), `"Name":"netbox_site.Create"`)
+	assert.Contains(t, string(traces), `"netbox-ci"`)
+}
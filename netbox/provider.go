@@ -5,15 +5,17 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/status"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"golang.org/x/exp/slices"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 type providerState struct {
@@ -22,6 +24,33 @@ type providerState struct {
 
 	// concurrent access ok, only populated on provider start
 	tagCache map[string]*models.NestedTag
+
+	// one of conflictDetectionModes
+	conflictDetection string
+
+	// nil if the version check is skipped
+	netboxVersion *version.Version
+	// base URLs of the installed plugins, nil if unknown
+	installedPlugins map[string]bool
+
+	// populated on first use by getObjectTypeNames, shared by all copies of
+	// the state
+	objectTypes *objectTypeNamesCache
+
+	// populated on first use by getOpenAPISchema
+	openAPISchema *openAPISchemaCache
+}
+
+type objectTypeNamesCache struct {
+	once  sync.Once
+	names []string
+	err   error
+}
+
+type openAPISchemaCache struct {
+	once   sync.Once
+	schema openAPISchema
+	err    error
 }
 
 // This makes the description contain the default value, particularly useful for the docs
@@ -119,6 +148,8 @@ func Provider() *schema.Provider {
 			"netbox_vlan":                                          resourceNetboxVlan(),
 			"netbox_vlan_group":                                    resourceNetboxVlanGroup(),
 			"netbox_available_vlan":                                resourceNetboxAvailableVLAN(),
+			"netbox_vlan_translation_policy":                       resourceNetboxVlanTranslationPolicy(),
+			"netbox_vlan_translation_rule":                         resourceNetboxVlanTranslationRule(),
 			"netbox_ipam_role":                                     resourceNetboxIpamRole(),
 			"netbox_fhrp_group":                                    resourceNetboxFhrpGroup(),
 			"netbox_fhrp_group_assignment":                         resourceNetboxFhrpGroupAssignment(),
@@ -137,6 +168,8 @@ func Provider() *schema.Provider {
 			"netbox_token":                                         resourceNetboxToken(),
 			"netbox_custom_field":                                  resourceCustomField(),
 			"netbox_asn":                                           resourceNetboxAsn(),
+			"netbox_asn_range":                                     resourceNetboxAsnRange(),
+			"netbox_available_asn":                                 resourceNetboxAvailableAsn(),
 			"netbox_location":                                      resourceNetboxLocation(),
 			"netbox_site_group":                                    resourceNetboxSiteGroup(),
 			"netbox_rack":                                          resourceNetboxRack(),
@@ -171,6 +204,25 @@ func Provider() *schema.Provider {
 			"netbox_virtual_disk":                                  resourceNetboxVirtualDisks(),
 			"netbox_config_template":                               resourceNetboxConfigTemplate(),
 			"netbox_event_rule":                                    resourceNetboxEventRule(),
+			"netbox_custom_link":                                   resourceNetboxCustomLink(),
+			"netbox_export_template":                               resourceNetboxExportTemplate(),
+			"netbox_saved_filter":                                  resourceNetboxSavedFilter(),
+			"netbox_journal_entry":                                 resourceNetboxJournalEntry(),
+			"netbox_branch":                                        resourceNetboxBranch(),
+			"netbox_plugin_object":                                 resourceNetboxPluginObject(),
+			"netbox_dns_view":                                      resourceNetboxDNSView(),
+			"netbox_dns_nameserver":                                resourceNetboxDNSNameserver(),
+			"netbox_dns_zone":                                      resourceNetboxDNSZone(),
+			"netbox_dns_record":                                    resourceNetboxDNSRecord(),
+			"netbox_bgp_session":                                   resourceNetboxBGPSession(),
+			"netbox_bgp_peer_group":                                resourceNetboxBGPPeerGroup(),
+			"netbox_bgp_routing_policy":                            resourceNetboxBGPRoutingPolicy(),
+			"netbox_bgp_prefix_list":                               resourceNetboxBGPPrefixList(),
+			"netbox_notification_group":                            resourceNetboxNotificationGroup(),
+			"netbox_subscription":                                  resourceNetboxSubscription(),
+			"netbox_script_run":                                    resourceNetboxScriptRun(),
+			"netbox_data_source":                                   resourceNetboxDataSource(),
+			"netbox_data_source_sync":                              resourceNetboxDataSourceSync(),
 			"netbox_vpn_tunnel_group":                              resourceNetboxVpnTunnelGroup(),
 			"netbox_vpn_tunnel":                                    resourceNetboxVpnTunnel(),
 			"netbox_vpn_tunnel_termination":                        resourceNetboxVpnTunnelTermination(),
@@ -178,63 +230,83 @@ func Provider() *schema.Provider {
 			"netbox_mac_address":                                   resourceNetboxMACAddress(),
 			"netbox_wireless_lan_group":                            resourceNetboxWirelessLANGroup(),
 			"netbox_wireless_lan":                                  resourceNetboxWirelessLAN(),
+			"netbox_wireless_link":                                 resourceNetboxWirelessLink(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"netbox_asn":                     dataSourceNetboxAsn(),
-			"netbox_asns":                    dataSourceNetboxAsns(),
-			"netbox_available_prefix":        dataSourceNetboxAvailablePrefix(),
-			"netbox_cluster":                 dataSourceNetboxCluster(),
-			"netbox_clusters":                dataSourceNetboxClusters(),
-			"netbox_cluster_group":           dataSourceNetboxClusterGroup(),
-			"netbox_cluster_type":            dataSourceNetboxClusterType(),
-			"netbox_contact":                 dataSourceNetboxContact(),
-			"netbox_contact_role":            dataSourceNetboxContactRole(),
-			"netbox_contact_group":           dataSourceNetboxContactGroup(),
-			"netbox_tenant":                  dataSourceNetboxTenant(),
-			"netbox_tenants":                 dataSourceNetboxTenants(),
-			"netbox_tenant_group":            dataSourceNetboxTenantGroup(),
-			"netbox_vrf":                     dataSourceNetboxVrf(),
-			"netbox_vrfs":                    dataSourceNetboxVrfs(),
-			"netbox_platform":                dataSourceNetboxPlatform(),
-			"netbox_prefix":                  dataSourceNetboxPrefix(),
-			"netbox_prefixes":                dataSourceNetboxPrefixes(),
-			"netbox_devices":                 dataSourceNetboxDevices(),
-			"netbox_device_role":             dataSourceNetboxDeviceRole(),
-			"netbox_device_type":             dataSourceNetboxDeviceType(),
-			"netbox_rack_type":               dataSourceNetboxRackType(),
-			"netbox_manufacturers":           dataSourceNetboxManufacturer(),
-			"netbox_site":                    dataSourceNetboxSite(),
-			"netbox_location":                dataSourceNetboxLocation(),
-			"netbox_locations":               dataSourceNetboxLocations(),
-			"netbox_tag":                     dataSourceNetboxTag(),
-			"netbox_tags":                    dataSourceNetboxTags(),
-			"netbox_virtual_machines":        dataSourceNetboxVirtualMachine(),
-			"netbox_interfaces":              dataSourceNetboxInterfaces(),
-			"netbox_device_interfaces":       dataSourceNetboxDeviceInterfaces(),
-			"netbox_device_power_ports":      dataSourceNetboxDevicePowerPorts(),
-			"netbox_device_power_outlets":    dataSourceNetboxDevicePowerOutlets(),
-			"netbox_ipam_role":               dataSourceNetboxIPAMRole(),
-			"netbox_fhrp_group":              dataSourceNetboxFhrpGroup(),
-			"netbox_route_target":            dataSourceNetboxRouteTarget(),
-			"netbox_ip_address":              dataSourceNetboxIPAddress(),
-			"netbox_ip_addresses":            dataSourceNetboxIPAddresses(),
-			"netbox_ip_range":                dataSourceNetboxIPRange(),
-			"netbox_ip_ranges":               dataSourceNetboxIPRanges(),
-			"netbox_region":                  dataSourceNetboxRegion(),
-			"netbox_rir":                     dataSourceNetboxRir(),
-			"netbox_vlan":                    dataSourceNetboxVlan(),
-			"netbox_vlans":                   dataSourceNetboxVlans(),
-			"netbox_vlan_group":              dataSourceNetboxVlanGroup(),
-			"netbox_vlan_groups":             dataSourceNetboxVlanGroups(),
-			"netbox_vpn_tunnel":              dataSourceNetboxVpnTunnel(),
-			"netbox_vpn_tunnel_group":        dataSourceNetboxVpnTunnelGroup(),
-			"netbox_vpn_tunnel_terminations": dataSourceNetboxVpnTunnelTerminations(),
-			"netbox_site_group":              dataSourceNetboxSiteGroup(),
-			"netbox_racks":                   dataSourceNetboxRacks(),
-			"netbox_rack_role":               dataSourceNetboxRackRole(),
-			"netbox_config_context":          dataSourceNetboxConfigContext(),
-			"netbox_virtual_disk":            dataSourceNetboxVirtualDisk(),
-			"netbox_device_render_config":    dataSourceNetboxDeviceRenderConfig(),
+			"netbox_asn":                           dataSourceNetboxAsn(),
+			"netbox_asns":                          dataSourceNetboxAsns(),
+			"netbox_asn_ranges":                    dataSourceNetboxAsnRanges(),
+			"netbox_available_prefix":              dataSourceNetboxAvailablePrefix(),
+			"netbox_cluster":                       dataSourceNetboxCluster(),
+			"netbox_clusters":                      dataSourceNetboxClusters(),
+			"netbox_cluster_group":                 dataSourceNetboxClusterGroup(),
+			"netbox_cluster_type":                  dataSourceNetboxClusterType(),
+			"netbox_contact":                       dataSourceNetboxContact(),
+			"netbox_contact_role":                  dataSourceNetboxContactRole(),
+			"netbox_contact_group":                 dataSourceNetboxContactGroup(),
+			"netbox_tenant":                        dataSourceNetboxTenant(),
+			"netbox_tenants":                       dataSourceNetboxTenants(),
+			"netbox_tenant_group":                  dataSourceNetboxTenantGroup(),
+			"netbox_vrf":                           dataSourceNetboxVrf(),
+			"netbox_vrfs":                          dataSourceNetboxVrfs(),
+			"netbox_platform":                      dataSourceNetboxPlatform(),
+			"netbox_prefix":                        dataSourceNetboxPrefix(),
+			"netbox_prefixes":                      dataSourceNetboxPrefixes(),
+			"netbox_prefix_utilization":            dataSourceNetboxPrefixUtilization(),
+			"netbox_devices":                       dataSourceNetboxDevices(),
+			"netbox_device_role":                   dataSourceNetboxDeviceRole(),
+			"netbox_device_type":                   dataSourceNetboxDeviceType(),
+			"netbox_rack_type":                     dataSourceNetboxRackType(),
+			"netbox_manufacturers":                 dataSourceNetboxManufacturer(),
+			"netbox_site":                          dataSourceNetboxSite(),
+			"netbox_location":                      dataSourceNetboxLocation(),
+			"netbox_locations":                     dataSourceNetboxLocations(),
+			"netbox_tag":                           dataSourceNetboxTag(),
+			"netbox_tags":                          dataSourceNetboxTags(),
+			"netbox_virtual_machines":              dataSourceNetboxVirtualMachine(),
+			"netbox_interfaces":                    dataSourceNetboxInterfaces(),
+			"netbox_device_interfaces":             dataSourceNetboxDeviceInterfaces(),
+			"netbox_device_power_ports":            dataSourceNetboxDevicePowerPorts(),
+			"netbox_device_power_outlets":          dataSourceNetboxDevicePowerOutlets(),
+			"netbox_ipam_role":                     dataSourceNetboxIPAMRole(),
+			"netbox_fhrp_group":                    dataSourceNetboxFhrpGroup(),
+			"netbox_route_target":                  dataSourceNetboxRouteTarget(),
+			"netbox_ip_address":                    dataSourceNetboxIPAddress(),
+			"netbox_ip_addresses":                  dataSourceNetboxIPAddresses(),
+			"netbox_ip_range":                      dataSourceNetboxIPRange(),
+			"netbox_ip_ranges":                     dataSourceNetboxIPRanges(),
+			"netbox_region":                        dataSourceNetboxRegion(),
+			"netbox_rir":                           dataSourceNetboxRir(),
+			"netbox_vlan":                          dataSourceNetboxVlan(),
+			"netbox_vlans":                         dataSourceNetboxVlans(),
+			"netbox_vlan_group":                    dataSourceNetboxVlanGroup(),
+			"netbox_vlan_groups":                   dataSourceNetboxVlanGroups(),
+			"netbox_vpn_tunnel":                    dataSourceNetboxVpnTunnel(),
+			"netbox_vpn_tunnel_group":              dataSourceNetboxVpnTunnelGroup(),
+			"netbox_vpn_tunnel_terminations":       dataSourceNetboxVpnTunnelTerminations(),
+			"netbox_wireless_link":                 dataSourceNetboxWirelessLink(),
+			"netbox_site_group":                    dataSourceNetboxSiteGroup(),
+			"netbox_racks":                         dataSourceNetboxRacks(),
+			"netbox_rack_role":                     dataSourceNetboxRackRole(),
+			"netbox_config_context":                dataSourceNetboxConfigContext(),
+			"netbox_virtual_disk":                  dataSourceNetboxVirtualDisk(),
+			"netbox_device_render_config":          dataSourceNetboxDeviceRenderConfig(),
+			"netbox_virtual_machine_render_config": dataSourceNetboxVirtualMachineRenderConfig(),
+			"netbox_config_template_render":        dataSourceNetboxConfigTemplateRender(),
+			"netbox_journal_entries":               dataSourceNetboxJournalEntries(),
+			"netbox_content_types":                 dataSourceNetboxContentTypes(),
+			"netbox_script":                        dataSourceNetboxScript(),
+			"netbox_data_file":                     dataSourceNetboxDataFile(),
+			"netbox_branch":                        dataSourceNetboxBranch(),
+			"netbox_object_changes":                dataSourceNetboxObjectChanges(),
+			"netbox_dns_view":                      dataSourceNetboxDNSView(),
+			"netbox_dns_nameserver":                dataSourceNetboxDNSNameserver(),
+			"netbox_dns_zone":                      dataSourceNetboxDNSZone(),
+			"netbox_dns_record":                    dataSourceNetboxDNSRecord(),
+			"netbox_bgp_session":                   dataSourceNetboxBGPSession(),
+			"netbox_bgp_peer_group":                dataSourceNetboxBGPPeerGroup(),
+			"netbox_bgp_routing_policy":            dataSourceNetboxBGPRoutingPolicy(),
+			"netbox_bgp_prefix_list":               dataSourceNetboxBGPPrefixList(),
 		},
 		Schema: map[string]*schema.Schema{
 			"server_url": {
@@ -245,9 +317,21 @@ func Provider() *schema.Provider {
 			},
 			"api_token": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NETBOX_API_TOKEN", nil),
-				Description: "Netbox API authentication token. Supports both v1 tokens (`Authorization: Token <key>`) and v2 tokens (`Authorization: Bearer nbt_<key>.<token>`). V2 tokens are auto-detected by their `nbt_` prefix. Can be set via the `NETBOX_API_TOKEN` environment variable.",
+				Description: "Netbox API authentication token. Supports both v1 tokens (`Authorization: Token <key>`) and v2 tokens (`Authorization: Bearer nbt_<key>.<token>`). V2 tokens are auto-detected by their `nbt_` prefix. Can be set via the `NETBOX_API_TOKEN` environment variable. Exactly one of `api_token`, `api_token_file` and `api_token_command` must be set.",
+			},
+			"api_token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_API_TOKEN_FILE", nil),
+				Description: "Path to a file containing the Netbox API authentication token, e.g. rendered by Vault Agent. The file is read again when Netbox rejects the token, so it can be rotated while the provider runs. Can be set via the `NETBOX_API_TOKEN_FILE` environment variable.",
+			},
+			"api_token_command": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_API_TOKEN_COMMAND", nil),
+				Description: "A command that prints the Netbox API authentication token to stdout, run by `sh -c` (`cmd /C` on Windows). The command is run again when Netbox rejects the token. Can be set via the `NETBOX_API_TOKEN_COMMAND` environment variable.",
 			},
 			"allow_insecure_https": {
 				Type:        schema.TypeBool,
@@ -287,16 +371,91 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "Tags to add to every resource managed by this provider",
 			},
+			"branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_BRANCH", nil),
+				Description: "The name of a branch of the netbox-branching plugin to apply all changes to. The branch is resolved to its schema ID, which is sent in the `X-NetBox-Branch` header. Can be set via the `NETBOX_BRANCH` environment variable.",
+			},
+			"request_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_REQUEST_ID", nil),
+				Description: "The value of the `X-Request-ID` header sent with every request of this provider instance, e.g. the ID of a CI pipeline run. This correlates all requests of a Terraform run in the logs of proxies in front of Netbox. Netbox itself ignores the header: it generates its own request ID for the change log, so the change log entries of a run cannot be found by this value. Filter `netbox_object_changes` by user and time window instead. Can be set via the `NETBOX_REQUEST_ID` environment variable. Defaults to a random UUID per run.",
+			},
+			"sensitive_headers": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Names of headers whose values are redacted in logs and the trace body file, e.g. headers set via `headers` that carry secrets. The `Authorization`, `Proxy-Authorization`, `Cookie` and `Set-Cookie` headers are always redacted.",
+			},
+			"trace_body_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_TRACE_BODY_FILE", nil),
+				Description: "Path of a file to append all requests and responses to, including their bodies, e.g. to attach to a support ticket. Sensitive headers are redacted, but the bodies are written as is and may contain secrets such as token keys. Can be set via the `NETBOX_TRACE_BODY_FILE` environment variable.",
+			},
+			"conflict_detection": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("NETBOX_CONFLICT_DETECTION", conflictDetectionOff),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(conflictDetectionModes, false)),
+				Description:      "Whether to check objects for changes made outside of Terraform since Terraform last created or updated them, before updating them again. With `warn`, the update overwrites the changes and reports them as a warning. With `error`, the update fails. Either mode records `last_updated` in the state of every resource after creating or updating it, which costs an additional request per change. A refresh keeps the recorded time, so changes made outside of Terraform are detected even if the state was refreshed since. Can be set via the `NETBOX_CONFLICT_DETECTION` environment variable. " + buildValidValueDescription(conflictDetectionModes),
+			},
 			"ca_cert_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NETBOX_CA_CERT_FILE", nil),
 				Description: "Path to a PEM-encoded CA certificate for verifying the Netbox server certificate. Can be set via the `NETBOX_CA_CERT_FILE` environment variable.",
 			},
+			"client_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CLIENT_CERT_FILE", nil),
+				ConflictsWith: []string{"client_cert"},
+				Description:   "Path to a PEM-encoded client certificate for mutual TLS, optionally followed by intermediate certificates. Requires `client_key_file` or `client_key`. Can be set via the `NETBOX_CLIENT_CERT_FILE` environment variable.",
+			},
+			"client_key_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CLIENT_KEY_FILE", nil),
+				ConflictsWith: []string{"client_key"},
+				Description:   "Path to the PEM-encoded private key of the client certificate. Can be set via the `NETBOX_CLIENT_KEY_FILE` environment variable.",
+			},
+			"client_cert": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CLIENT_CERT", nil),
+				ConflictsWith: []string{"client_cert_file"},
+				Description:   "PEM-encoded client certificate for mutual TLS, as an alternative to `client_cert_file`. Can be set via the `NETBOX_CLIENT_CERT` environment variable.",
+			},
+			"client_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CLIENT_KEY", nil),
+				ConflictsWith: []string{"client_key_file"},
+				Description:   "PEM-encoded private key of the client certificate, as an alternative to `client_key_file`. Can be set via the `NETBOX_CLIENT_KEY` environment variable.",
+			},
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
 
+	for name, object := range changeLoggedResources {
+		addConflictDetection(provider.ResourcesMap[name], object)
+	}
+
+	for name, def := range provider.ResourcesMap {
+		addOpenTelemetrySpans(def, name, name)
+	}
+	for name, def := range provider.DataSourcesMap {
+		addOpenTelemetrySpans(def, "data."+name, name)
+	}
+
+	addCapabilityChecks(provider)
+
 	// all resources that have tags get a custom diff function
 	for _, def := range provider.ResourcesMap {
 		if _, ok := def.Schema[tagsKey]; ok {
@@ -315,6 +474,10 @@ func Provider() *schema.Provider {
 func providerConfigure(ctx context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
+	if err := setupOpenTelemetry(); err != nil {
+		return nil, diag.FromErr(fmt.Errorf("error setting up OpenTelemetry tracing: %w", err))
+	}
+
 	config := Config{
 		APIToken:                    data.Get("api_token").(string),
 		AllowInsecureHTTPS:          data.Get("allow_insecure_https").(bool),
@@ -322,6 +485,16 @@ func providerConfigure(ctx context.Context, data *schema.ResourceData) (interfac
 		RequestTimeout:              data.Get("request_timeout").(int),
 		StripTrailingSlashesFromURL: data.Get("strip_trailing_slashes_from_url").(bool),
 		CACertFile:                  data.Get("ca_cert_file").(string),
+		ClientCertFile:              data.Get("client_cert_file").(string),
+		ClientKeyFile:               data.Get("client_key_file").(string),
+		ClientCert:                  data.Get("client_cert").(string),
+		ClientKey:                   data.Get("client_key").(string),
+		APITokenFile:                data.Get("api_token_file").(string),
+		APITokenCommand:             data.Get("api_token_command").(string),
+		RequestID:                   data.Get("request_id").(string),
+		SensitiveHeaders:            toStringList(data.Get("sensitive_headers")),
+		TraceBodyFile:               data.Get("trace_body_file").(string),
+		LogContext:                  ctx,
 	}
 
 	serverURL := data.Get("server_url").(string)
@@ -355,9 +528,34 @@ func providerConfigure(ctx context.Context, data *schema.ResourceData) (interfac
 		return nil, diag.FromErr(clientError)
 	}
 
+	// Resolve the branch before selecting it, the branches are managed in main
+	if branchName := data.Get("branch").(string); branchName != "" {
+		b, err := getBranchByName(&providerState{NetBoxAPI: netboxClient}, branchName)
+		if err != nil {
+			return nil, diag.FromErr(fmt.Errorf("error resolving branch %q: %w", branchName, err))
+		}
+		if b.status() != "ready" {
+			return nil, diag.Errorf("branch %q has status %q, changes can only be applied to branches with status \"ready\"", branchName, b.status())
+		}
+
+		headers := make(map[string]interface{}, len(config.Headers)+1)
+		for key, value := range config.Headers {
+			headers[key] = value
+		}
+		headers[branchHeader] = b.SchemaID
+		config.Headers = headers
+
+		netboxClient, clientError = config.Client()
+		if clientError != nil {
+			return nil, diag.FromErr(clientError)
+		}
+	}
+
 	// Unless explicitly switched off, use the client to retrieve the Netbox version
 	// so we can determine compatibility of the provider with the used Netbox
 	skipVersionCheck := data.Get("skip_version_check").(bool)
+	var runningVersion *version.Version
+	var installedPlugins map[string]bool
 
 	if !skipVersionCheck {
 		// The status endpoint can be transiently unavailable (e.g. Netbox still
@@ -401,14 +599,29 @@ func providerConfigure(ctx context.Context, data *schema.ResourceData) (interfac
 			return nil, diag.FromErr(fmt.Errorf("error extracting netbox version. try using the `skip_version_check` provider parameter to bypass this error. original error: %w", err))
 		}
 
-		supportedVersions := []string{"4.3.0", "4.3.2", "4.3.3", "4.3.4", "4.3.5", "4.3.6", "4.3.7", "4.4.0", "4.4.1", "4.4.2", "4.4.4", "4.4.5", "4.4.6", "4.4.7", "4.4.8", "4.4.9", "4.4.10", "4.5.1", "4.5.2", "4.5.3", "4.5.4", "4.5.5", "4.5.6", "4.5.7", "4.5.8", "4.5.9", "4.5.10", "4.6.0", "4.6.1", "4.6.2", "4.6.3", "4.6.4", "4.6.5"}
+		parsedVersion, err := version.NewVersion(netboxVersion)
+		if err != nil {
+			return nil, diag.FromErr(fmt.Errorf("error parsing netbox version. try using the `skip_version_check` provider parameter to bypass this error. original error: %w", err))
+		}
+		runningVersion = parsedVersion
 
-		if !slices.Contains(supportedVersions, netboxVersion) {
+		if !isSupportedNetboxVersion(runningVersion) {
 			// Currently, there is no way to test these warnings. There is an issue to track this: https://github.com/hashicorp/terraform-plugin-sdk/issues/864
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Warning,
 				Summary:  "Possibly unsupported Netbox version",
-				Detail:   fmt.Sprintf("Your Netbox reports version %v. From that, the provider extracted Netbox version %v.\nThe provider was successfully tested against the following versions:\n\n  %v\n\nUnexpected errors may occur.", netboxVersionStringFromAPI, netboxVersion, strings.Join(supportedVersions, ", ")),
+				Detail:   fmt.Sprintf("Your Netbox reports version %v. From that, the provider extracted Netbox version %v.\nThe provider was successfully tested against the following versions:\n\n  %v\n\nUnexpected errors may occur.", netboxVersionStringFromAPI, netboxVersion, strings.Join(supportedNetboxVersions, ", ")),
+			})
+		}
+
+		// Resources of plugins that are not installed fail at plan time with
+		// a clear error, instead of with a 404 of the API at apply time
+		installedPlugins, err = getInstalledPlugins(&providerState{NetBoxAPI: netboxClient})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to determine the installed Netbox plugins",
+				Detail:   fmt.Sprintf("The provider could not list the plugins at `%s/api/plugins/`, so resources of plugins are not checked before they are applied.\n\nOriginal error: %s", serverURL, err),
 			})
 		}
 	}
@@ -436,6 +649,12 @@ func providerConfigure(ctx context.Context, data *schema.ResourceData) (interfac
 		NetBoxAPI:   netboxClient,
 		defaultTags: schema.CopySet(tags),
 		tagCache:    tagCache,
+
+		conflictDetection: data.Get("conflict_detection").(string),
+		objectTypes:       &objectTypeNamesCache{},
+		openAPISchema:     &openAPISchemaCache{},
+		netboxVersion:     runningVersion,
+		installedPlugins:  installedPlugins,
 	}
 	return state, diags
 }
@@ -0,0 +1,276 @@
+package netbox
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-viper/mapstructure/v2"
+)
+
+// The helpers in this file cover Netbox API endpoints and attributes that are
+// not (yet) part of the go-netbox client. All requests are sent through the
+// transport of the configured client, so authentication, custom headers and
+// TLS settings apply to them as well.
+
+// netboxRawAPIError is returned by netboxRawRequest for non-2xx responses.
+// Like the errors of the generated client, it exposes the status code via Code().
+type netboxRawAPIError struct {
+	method string
+	path   string
+	code   int
+	body   string
+}
+
+func (e *netboxRawAPIError) Error() string {
+	return fmt.Sprintf("[%s %s][%d] %s", e.method, e.path, e.code, e.body)
+}
+
+// Code returns the HTTP status code of the failed request.
+func (e *netboxRawAPIError) Code() int {
+	return e.code
+}
+
+// rawNestedObject captures the ID of a nested object in a raw API response.
+type rawNestedObject struct {
+	ID int64 `json:"id"`
+}
+
+// idOrNil returns the ID of the nested object, or nil if it is not set.
+func (o *rawNestedObject) idOrNil() *int64 {
+	if o == nil {
+		return nil
+	}
+	return &o.ID
+}
+
+// rawChoice captures a choice field in a raw API response.
+type rawChoice struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+// UnmarshalJSON also accepts plain values, as some plugins serialize choice
+// fields without label.
+func (c *rawChoice) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		*c = rawChoice{Value: value}
+		return nil
+	}
+
+	type choice rawChoice
+	var parsed choice
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return err
+	}
+	*c = rawChoice(parsed)
+	return nil
+}
+
+// rawObjectTypes captures the object types an object applies to. Some
+// go-netbox models still use the pre-4.0 `content_types` name for this field.
+type rawObjectTypes struct {
+	ObjectTypes []string `json:"object_types"`
+}
+
+type rawRequestParams struct {
+	query url.Values
+	body  interface{}
+}
+
+func (p rawRequestParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+	for key, values := range p.query {
+		if err := r.SetQueryParam(key, values...); err != nil {
+			return err
+		}
+	}
+	if p.body != nil {
+		return r.SetBodyParam(p.body)
+	}
+	return nil
+}
+
+// netboxRawRequest sends a JSON request to the given API path, e.g.
+// "/ipam/vlan-translation-policies/". The path is relative to the API base path.
+// If body is nil, no request body is sent. If result is not nil, the JSON
+// response body is decoded into it.
+func netboxRawRequest(api *providerState, method, path string, query url.Values, body, result interface{}) error {
+	op := &runtime.ClientOperation{
+		ID:                 method + " " + path,
+		Method:             method,
+		PathPattern:        path,
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             rawRequestParams{query: query, body: body},
+		Reader: runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+			if response.Code() < 200 || response.Code() > 299 {
+				respBody, _ := io.ReadAll(response.Body())
+				return nil, &netboxRawAPIError{method: method, path: path, code: response.Code(), body: string(respBody)}
+			}
+			if result == nil || response.Code() == 204 {
+				return nil, nil
+			}
+			return nil, consumer.Consume(response.Body(), result)
+		}),
+	}
+
+	_, err := api.Transport.Submit(op)
+	return err
+}
+
+type bodyFieldsInterceptWriter struct {
+	runtime.ClientRequest
+	fields map[string]any
+}
+
+func (iw bodyFieldsInterceptWriter) SetBodyParam(p any) error {
+	out := make(map[string]any)
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		TagName: "json",
+		Result:  &out,
+	})
+	if err != nil {
+		return err
+	}
+	if err := dec.Decode(p); err != nil {
+		return err
+	}
+	for fieldName, value := range iw.fields {
+		out[fieldName] = value
+	}
+	return iw.ClientRequest.SetBodyParam(out)
+}
+
+type bodyFieldsInterceptParams struct {
+	inner  runtime.ClientRequestWriter
+	fields map[string]any
+}
+
+func (ip bodyFieldsInterceptParams) WriteToRequest(req runtime.ClientRequest, reg strfmt.Registry) error {
+	writer := bodyFieldsInterceptWriter{ClientRequest: req, fields: ip.fields}
+	return ip.inner.WriteToRequest(writer, reg)
+}
+
+// hackSerializeWithValues is a client option that sets the given fields in
+// the JSON request body, overriding what the go-netbox model serializes.
+// A nil value is serialized as an explicit `null`.
+func hackSerializeWithValues(fields map[string]any) func(*runtime.ClientOperation) {
+	overrideFields := make(map[string]any, len(fields))
+	for fieldName, value := range fields {
+		overrideFields[fieldName] = value
+	}
+	return func(co *runtime.ClientOperation) {
+		originalParams := co.Params
+		co.Params = bodyFieldsInterceptParams{inner: originalParams, fields: overrideFields}
+	}
+}
+
+type bufferedClientResponse struct {
+	runtime.ClientResponse
+	body []byte
+}
+
+func (r bufferedClientResponse) Body() io.ReadCloser {
+	return io.NopCloser(bytes.NewReader(r.body))
+}
+
+// withRawResponse is a client option that additionally decodes a successful
+// JSON response into target. It is used to read attributes that the
+// go-netbox models drop, without sending a second request.
+func withRawResponse(target any) func(*runtime.ClientOperation) {
+	return func(co *runtime.ClientOperation) {
+		inner := co.Reader
+		co.Reader = runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+			body, err := io.ReadAll(response.Body())
+			if err != nil {
+				return nil, err
+			}
+			if response.Code() >= 200 && response.Code() <= 299 && len(body) > 0 {
+				if err := json.Unmarshal(body, target); err != nil {
+					return nil, err
+				}
+			}
+			return inner.ReadResponse(bufferedClientResponse{ClientResponse: response, body: body}, consumer)
+		})
+	}
+}
+
+type rawListPage[T any] struct {
+	Count   int64       `json:"count"`
+	Next    *strfmt.URI `json:"next"`
+	Results []T         `json:"results"`
+}
+
+// netboxRawList fetches all pages of a list endpoint, stopping early once
+// userLimit results have been collected (0 fetches everything).
+func netboxRawList[T any](api *providerState, path string, query url.Values, userLimit int64) ([]T, error) {
+	paginationHelper := NewPaginationHelper(userLimit)
+	var all []T
+
+	pageSize := paginationHelper.GetPageSize()
+	for {
+		currentOffset := paginationHelper.CurrentOffset()
+		pageQuery := url.Values{}
+		for key, values := range query {
+			pageQuery[key] = values
+		}
+		pageQuery.Set("limit", strconv.FormatInt(pageSize, 10))
+		pageQuery.Set("offset", strconv.FormatInt(currentOffset, 10))
+
+		var page rawListPage[T]
+		if err := netboxRawRequest(api, "GET", path, pageQuery, nil, &page); err != nil {
+			return nil, fmt.Errorf("failed to fetch %s at offset %d: %w", path, currentOffset, err)
+		}
+		all = append(all, page.Results...)
+
+		if len(page.Results) == 0 {
+			break
+		}
+
+		if !paginationHelper.ShouldContinuePaging(int64(len(all)), page.Next) {
+			break
+		}
+
+		paginationHelper.Advance(int64(len(page.Results)))
+	}
+
+	return all[:paginationHelper.TrimToLimit(len(all))], nil
+}
+
+// netboxRawGetUnique returns the only object of the list endpoint that
+// matches the query. objectName is used in the errors, e.g. "DNS zone".
+func netboxRawGetUnique[T any](api *providerState, path string, query url.Values, objectName string) (*T, error) {
+	pageQuery := url.Values{}
+	for key, values := range query {
+		pageQuery[key] = values
+	}
+	pageQuery.Set("limit", "2")
+
+	var page rawListPage[T]
+	if err := netboxRawRequest(api, "GET", path, pageQuery, nil, &page); err != nil {
+		return nil, err
+	}
+
+	if page.Count > int64(1) {
+		return nil, fmt.Errorf("more than one %s returned, specify a more narrow filter", objectName)
+	}
+	if page.Count == int64(0) || len(page.Results) == 0 {
+		return nil, fmt.Errorf("no %s found matching filter", objectName)
+	}
+	return &page.Results[0], nil
+}
+
+// isSupportedRawFilter reports whether name, or the filter it negates with
+// the `__n` suffix, is one of the supported filters.
+func isSupportedRawFilter(supported []string, name string) bool {
+	return slices.Contains(supported, strings.TrimSuffix(name, "__n"))
+}
@@ -0,0 +1,208 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type asnRange struct {
+	ID          int64               `json:"id,omitempty"`
+	Name        string              `json:"name"`
+	Slug        string              `json:"slug"`
+	Rir         *rawNestedObject    `json:"rir"`
+	Start       int64               `json:"start"`
+	End         int64               `json:"end"`
+	Tenant      *rawNestedObject    `json:"tenant"`
+	Description string              `json:"description"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+type writableASNRange struct {
+	Name        string              `json:"name"`
+	Slug        string              `json:"slug"`
+	Rir         int64               `json:"rir"`
+	Start       int64               `json:"start"`
+	End         int64               `json:"end"`
+	Tenant      *int64              `json:"tenant"`
+	Description string              `json:"description"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+func resourceNetboxAsnRange() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxAsnRangeCreate,
+		Read:   resourceNetboxAsnRangeRead,
+		Update: resourceNetboxAsnRangeUpdate,
+		Delete: resourceNetboxAsnRangeDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://netboxlabs.com/docs/netbox/models/ipam/asnrange/):
+
+> Ranges can be defined to group AS numbers numerically and to facilitate their automatic provisioning. Each range must be assigned to a Regional Internet Registry (RIR).
+
+Use the ` + "`netbox_available_asn`" + ` resource to allocate the next free ASN from a range.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"rir_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"start": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The first AS number of the range.",
+			},
+			"end": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The last AS number of the range.",
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getAsnRangeFromResourceData(api *providerState, d *schema.ResourceData) (*writableASNRange, error) {
+	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+	slugValue, slugOk := d.GetOk("slug")
+	var slug string
+	// Default slug to generated slug if not given
+	if !slugOk {
+		slug = getSlug(name)
+	} else {
+		slug = slugValue.(string)
+	}
+
+	return &writableASNRange{
+		Name:        name,
+		Slug:        slug,
+		Rir:         int64(d.Get("rir_id").(int)),
+		Start:       int64(d.Get("start").(int)),
+		End:         int64(d.Get("end").(int)),
+		Tenant:      getOptionalInt(d, "tenant_id"),
+		Description: d.Get("description").(string),
+		Tags:        tags,
+	}, nil
+}
+
+func resourceNetboxAsnRangeCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data, err := getAsnRangeFromResourceData(api, d)
+	if err != nil {
+		return err
+	}
+
+	var res asnRange
+	err = netboxRawRequest(api, "POST", "/ipam/asn-ranges/", nil, data, &res)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxAsnRangeRead(d, m)
+}
+
+func resourceNetboxAsnRangeRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	var asnRange asnRange
+	err := netboxRawRequest(api, "GET", fmt.Sprintf("/ipam/asn-ranges/%s/", d.Id()), nil, nil, &asnRange)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("name", asnRange.Name)
+	d.Set("slug", asnRange.Slug)
+	d.Set("start", asnRange.Start)
+	d.Set("end", asnRange.End)
+	d.Set("description", asnRange.Description)
+
+	if asnRange.Rir != nil {
+		d.Set("rir_id", asnRange.Rir.ID)
+	} else {
+		d.Set("rir_id", nil)
+	}
+
+	if asnRange.Tenant != nil {
+		d.Set("tenant_id", asnRange.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	api.readTags(d, asnRange.Tags)
+
+	return nil
+}
+
+func resourceNetboxAsnRangeUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data, err := getAsnRangeFromResourceData(api, d)
+	if err != nil {
+		return err
+	}
+
+	err = netboxRawRequest(api, "PUT", fmt.Sprintf("/ipam/asn-ranges/%s/", d.Id()), nil, data, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxAsnRangeRead(d, m)
+}
+
+func resourceNetboxAsnRangeDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	err := netboxRawRequest(api, "DELETE", fmt.Sprintf("/ipam/asn-ranges/%s/", d.Id()), nil, nil, nil)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxAsnRange_basic(t *testing.T) {
+	testSlug := "asn_range_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_rir" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_asn_range" "test" {
+  name        = "%[1]s"
+  rir_id      = netbox_rir.test.id
+  start       = 4200000000
+  end         = 4200000099
+  tenant_id   = netbox_tenant.test.id
+  description = "my-description"
+  tags        = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "slug", getSlug(testName)),
+					resource.TestCheckResourceAttrPair("netbox_asn_range.test", "rir_id", "netbox_rir.test", "id"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "start", "4200000000"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "end", "4200000099"),
+					resource.TestCheckResourceAttrPair("netbox_asn_range.test", "tenant_id", "netbox_tenant.test", "id"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "description", "my-description"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "tags.0", testName),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_rir" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_asn_range" "test" {
+  name   = "%[1]s"
+  slug   = "%[1]s_slug"
+  rir_id = netbox_rir.test.id
+  start  = 64512
+  end    = 64520
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "slug", testName+"_slug"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "start", "64512"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "end", "64520"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "tenant_id", "0"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "tags.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_asn_range.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
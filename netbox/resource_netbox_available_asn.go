@@ -0,0 +1,173 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type writableCreateAvailableASN struct {
+	Description string              `json:"description,omitempty"`
+	Comments    string              `json:"comments,omitempty"`
+	Tenant      *int64              `json:"tenant,omitempty"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+func resourceNetboxAvailableAsn() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxAvailableAsnCreate,
+		Read:   resourceNetboxAvailableAsnRead,
+		Update: resourceNetboxAvailableAsnUpdate,
+		Delete: resourceNetboxAvailableAsnDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/features/ipam/#asn):
+> ASN is short for Autonomous System Number. This identifier is used in the BGP protocol to identify which "autonomous system" a particular prefix is originating and transiting through.
+
+This resource will retrieve the next available AS number from a given ASN range (specified by ID).`,
+
+		Schema: map[string]*schema.Schema{
+			"asn_range_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the ASN range to allocate the AS number from.",
+			},
+			"asn": {
+				Type:     schema.TypeInt,
+				Computed: true, // it's auto-assigned by NetBox, not user-supplied
+			},
+			"rir_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey: tagsSchema,
+		},
+	}
+}
+
+func resourceNetboxAvailableAsnCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	rangeID := d.Get("asn_range_id").(int)
+
+	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return err
+	}
+	data := &writableCreateAvailableASN{
+		Description: d.Get("description").(string),
+		Comments:    d.Get("comments").(string),
+		Tenant:      getOptionalInt(d, "tenant_id"),
+		Tags:        tags,
+	}
+
+	var asn struct {
+		ID  int64 `json:"id"`
+		Asn int64 `json:"asn"`
+	}
+	err = netboxRawRequest(api, "POST", fmt.Sprintf("/ipam/asn-ranges/%d/available-asns/", rangeID), nil, data, &asn)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(asn.ID, 10))
+	d.Set("asn", asn.Asn)
+	return resourceNetboxAvailableAsnRead(d, m)
+}
+
+func resourceNetboxAvailableAsnRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamAsnsReadParams().WithID(id)
+
+	res, err := api.Ipam.IpamAsnsRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamAsnsReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	asn := res.GetPayload()
+	d.Set("asn", asn.Asn)
+	d.Set("description", asn.Description)
+	d.Set("comments", asn.Comments)
+
+	if asn.Rir != nil {
+		d.Set("rir_id", asn.Rir.ID)
+	} else {
+		d.Set("rir_id", nil)
+	}
+
+	if asn.Tenant != nil {
+		d.Set("tenant_id", asn.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	api.readTags(d, asn.Tags)
+
+	return nil
+}
+
+func resourceNetboxAvailableAsnUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := models.WritableASN{
+		Asn:         int64ToPtr(int64(d.Get("asn").(int))),
+		Rir:         int64ToPtr(int64(d.Get("rir_id").(int))),
+		Description: d.Get("description").(string),
+		Comments:    d.Get("comments").(string),
+	}
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return err
+	}
+
+	params := ipam.NewIpamAsnsUpdateParams().WithID(id).WithData(&data)
+
+	// The tenant is omitted from the go-netbox model when empty, so it is sent explicitly to allow unsetting it
+	_, err = api.Ipam.IpamAsnsUpdate(params, nil, hackSerializeWithValues(map[string]any{"tenant": getOptionalInt(d, "tenant_id")}))
+	if err != nil {
+		return err
+	}
+	return resourceNetboxAvailableAsnRead(d, m)
+}
+
+func resourceNetboxAvailableAsnDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := ipam.NewIpamAsnsDeleteParams().WithID(id)
+	_, err := api.Ipam.IpamAsnsDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamAsnsDeleteDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxAvailableAsn_basic(t *testing.T) {
+	testSlug := "available_asn"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_rir" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_asn_range" "test" {
+  name   = "%[1]s"
+  rir_id = netbox_rir.test.id
+  start  = 4200001000
+  end    = 4200001001
+}
+
+resource "netbox_asn" "taken" {
+  asn    = 4200001000
+  rir_id = netbox_rir.test.id
+}
+
+resource "netbox_available_asn" "test" {
+  asn_range_id = netbox_asn_range.test.id
+  description  = "%[1]s"
+
+  depends_on = [netbox_asn.taken]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_asn.test", "asn", "4200001001"),
+					resource.TestCheckResourceAttrPair("netbox_available_asn.test", "rir_id", "netbox_rir.test", "id"),
+					resource.TestCheckResourceAttr("netbox_available_asn.test", "description", testName),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_rir" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_asn_range" "test" {
+  name   = "%[1]s"
+  rir_id = netbox_rir.test.id
+  start  = 4200001000
+  end    = 4200001001
+}
+
+resource "netbox_asn" "taken" {
+  asn    = 4200001000
+  rir_id = netbox_rir.test.id
+}
+
+resource "netbox_available_asn" "test" {
+  asn_range_id = netbox_asn_range.test.id
+  description  = "%[1]s_updated"
+  tenant_id    = netbox_tenant.test.id
+
+  depends_on = [netbox_asn.taken]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_asn.test", "asn", "4200001001"),
+					resource.TestCheckResourceAttr("netbox_available_asn.test", "description", testName+"_updated"),
+					resource.TestCheckResourceAttrPair("netbox_available_asn.test", "tenant_id", "netbox_tenant.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,168 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type bgpPeerGroup struct {
+	ID             int64               `json:"id"`
+	Name           string              `json:"name"`
+	Description    string              `json:"description"`
+	ImportPolicies []rawNestedObject   `json:"import_policies"`
+	ExportPolicies []rawNestedObject   `json:"export_policies"`
+	Comments       string              `json:"comments"`
+	Tags           []*models.NestedTag `json:"tags"`
+}
+
+type writableBGPPeerGroup struct {
+	Name           string              `json:"name"`
+	Description    string              `json:"description"`
+	ImportPolicies []int64             `json:"import_policies"`
+	ExportPolicies []int64             `json:"export_policies"`
+	Comments       string              `json:"comments"`
+	Tags           []*models.NestedTag `json:"tags"`
+}
+
+func resourceNetboxBGPPeerGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxBGPPeerGroupCreate,
+		ReadContext:   resourceNetboxBGPPeerGroupRead,
+		UpdateContext: resourceNetboxBGPPeerGroupUpdate,
+		DeleteContext: resourceNetboxBGPPeerGroupDelete,
+
+		Description: `:meta:subcategory:Plugins:Manages a peer group of the [netbox-bgp](https://github.com/netbox-community/netbox-bgp) plugin. Peer groups share routing policies between sessions.
+
+This resource requires the netbox-bgp plugin.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"import_policy_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the routing policies applied to received routes.",
+			},
+			"export_policy_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the routing policies applied to advertised routes.",
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getBGPPeerGroupFromResourceData(api *providerState, d *schema.ResourceData) (*writableBGPPeerGroup, error) {
+	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return &writableBGPPeerGroup{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		ImportPolicies: toInt64List(d.Get("import_policy_ids")),
+		ExportPolicies: toInt64List(d.Get("export_policy_ids")),
+		Comments:       d.Get("comments").(string),
+		Tags:           tags,
+	}, nil
+}
+
+func resourceNetboxBGPPeerGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getBGPPeerGroupFromResourceData(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var res bgpPeerGroup
+	if err := netboxRawRequest(api, "POST", bgpPluginPath+"/peer-group/", nil, data, &res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxBGPPeerGroupRead(ctx, d, m)
+}
+
+func resourceNetboxBGPPeerGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var group bgpPeerGroup
+	if err := netboxRawRequest(api, "GET", fmt.Sprintf("%s/peer-group/%s/", bgpPluginPath, d.Id()), nil, nil, &group); err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", group.Name)
+	d.Set("description", group.Description)
+	d.Set("import_policy_ids", getIDsFromRawNestedObjects(group.ImportPolicies))
+	d.Set("export_policy_ids", getIDsFromRawNestedObjects(group.ExportPolicies))
+	d.Set("comments", group.Comments)
+
+	api.readTags(d, group.Tags)
+
+	return nil
+}
+
+func resourceNetboxBGPPeerGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getBGPPeerGroupFromResourceData(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := netboxRawRequest(api, "PUT", fmt.Sprintf("%s/peer-group/%s/", bgpPluginPath, d.Id()), nil, data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxBGPPeerGroupRead(ctx, d, m)
+}
+
+func resourceNetboxBGPPeerGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	err := netboxRawRequest(api, "DELETE", fmt.Sprintf("%s/peer-group/%s/", bgpPluginPath, d.Id()), nil, nil, nil)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,419 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxBGPPrefixListFamilyOptions = []string{"ipv4", "ipv6"}
+var resourceNetboxBGPPrefixListRuleActionOptions = []string{"permit", "deny"}
+
+type bgpPrefixList struct {
+	ID          int64               `json:"id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Family      *rawChoice          `json:"family"`
+	Comments    string              `json:"comments"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+type writableBGPPrefixList struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Family      string              `json:"family"`
+	Comments    string              `json:"comments"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+type bgpPrefixListRule struct {
+	ID           int64            `json:"id"`
+	PrefixList   *rawNestedObject `json:"prefix_list"`
+	Index        int64            `json:"index"`
+	Action       *rawChoice       `json:"action"`
+	Prefix       *rawNestedObject `json:"prefix"`
+	PrefixCustom *string          `json:"prefix_custom"`
+	GE           *int64           `json:"ge"`
+	LE           *int64           `json:"le"`
+	Description  string           `json:"description"`
+}
+
+type writableBGPPrefixListRule struct {
+	PrefixList   int64   `json:"prefix_list"`
+	Index        int64   `json:"index"`
+	Action       string  `json:"action"`
+	Prefix       *int64  `json:"prefix"`
+	PrefixCustom *string `json:"prefix_custom"`
+	GE           *int64  `json:"ge"`
+	LE           *int64  `json:"le"`
+	Description  string  `json:"description"`
+}
+
+func resourceNetboxBGPPrefixList() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxBGPPrefixListCreate,
+		ReadContext:   resourceNetboxBGPPrefixListRead,
+		UpdateContext: resourceNetboxBGPPrefixListUpdate,
+		DeleteContext: resourceNetboxBGPPrefixListDelete,
+
+		Description: `:meta:subcategory:Plugins:Manages a prefix list of the [netbox-bgp](https://github.com/netbox-community/netbox-bgp) plugin, including its rules. Rules match either a prefix managed with ` + "`netbox_prefix`" + ` or a custom prefix.
+
+This resource requires the netbox-bgp plugin.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"family": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxBGPPrefixListFamilyOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxBGPPrefixListFamilyOptions),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The sequence number of the rule, unique within the prefix list.",
+						},
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(resourceNetboxBGPPrefixListRuleActionOptions, false),
+							Description:  buildValidValueDescription(resourceNetboxBGPPrefixListRuleActionOptions),
+						},
+						"prefix_id": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The ID of the matched prefix. Exactly one of `prefix_id` and `prefix` must be given.",
+						},
+						"prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsCIDR,
+							Description:  "The matched prefix, if it is not managed in NetBox.",
+						},
+						"ge": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 128),
+							Description:  "The minimum prefix length to match.",
+						},
+						"le": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 128),
+							Description:  "The maximum prefix length to match.",
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getBGPPrefixListFromResourceData(api *providerState, d *schema.ResourceData) (*writableBGPPrefixList, error) {
+	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return &writableBGPPrefixList{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Family:      d.Get("family").(string),
+		Comments:    d.Get("comments").(string),
+		Tags:        tags,
+	}, nil
+}
+
+// getBGPPrefixListRulesFromResourceData returns the configured rules, keyed
+// by their index.
+func getBGPPrefixListRulesFromResourceData(d *schema.ResourceData, prefixListID int64) (map[int64]writableBGPPrefixListRule, error) {
+	rules := make(map[int64]writableBGPPrefixListRule)
+	for _, raw := range d.Get("rule").(*schema.Set).List() {
+		ruleData := raw.(map[string]interface{})
+
+		rule := writableBGPPrefixListRule{
+			PrefixList:  prefixListID,
+			Index:       int64(ruleData["index"].(int)),
+			Action:      ruleData["action"].(string),
+			Description: ruleData["description"].(string),
+		}
+		if prefixID := ruleData["prefix_id"].(int); prefixID != 0 {
+			rule.Prefix = int64ToPtr(int64(prefixID))
+		}
+		if prefix := ruleData["prefix"].(string); prefix != "" {
+			rule.PrefixCustom = &prefix
+		}
+		if (rule.Prefix == nil) == (rule.PrefixCustom == nil) {
+			return nil, fmt.Errorf("rule %d: exactly one of prefix_id and prefix must be given", rule.Index)
+		}
+		if ge := ruleData["ge"].(int); ge != 0 {
+			rule.GE = int64ToPtr(int64(ge))
+		}
+		if le := ruleData["le"].(int); le != 0 {
+			rule.LE = int64ToPtr(int64(le))
+		}
+
+		if _, ok := rules[rule.Index]; ok {
+			return nil, fmt.Errorf("rule %d: the index of a rule must be unique", rule.Index)
+		}
+		rules[rule.Index] = rule
+	}
+	return rules, nil
+}
+
+// getBGPPrefixListRules returns the rules of the prefix list, sorted by their
+// index.
+func getBGPPrefixListRules(api *providerState, prefixListID int64) ([]bgpPrefixListRule, error) {
+	query := url.Values{}
+	query.Set("prefix_list", strconv.FormatInt(prefixListID, 10))
+
+	all, err := netboxRawList[bgpPrefixListRule](api, bgpPluginPath+"/prefix-list-rule/", query, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// in case the plugin ignores the filter
+	rules := make([]bgpPrefixListRule, 0, len(all))
+	for _, rule := range all {
+		if rule.PrefixList != nil && rule.PrefixList.ID == prefixListID {
+			rules = append(rules, rule)
+		}
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Index < rules[j].Index })
+	return rules, nil
+}
+
+func (r *bgpPrefixListRule) writable() writableBGPPrefixListRule {
+	rule := writableBGPPrefixListRule{
+		Index:        r.Index,
+		Prefix:       r.Prefix.idOrNil(),
+		PrefixCustom: r.PrefixCustom,
+		GE:           r.GE,
+		LE:           r.LE,
+		Description:  r.Description,
+	}
+	if r.PrefixList != nil {
+		rule.PrefixList = r.PrefixList.ID
+	}
+	if r.Action != nil {
+		rule.Action = r.Action.Value
+	}
+	return rule
+}
+
+// syncBGPPrefixListRules makes the rules of the prefix list match the
+// configured rules. Rules are matched by their index, so that unchanged rules
+// keep their ID.
+func syncBGPPrefixListRules(api *providerState, prefixListID int64, configured map[int64]writableBGPPrefixListRule) error {
+	existing, err := getBGPPrefixListRules(api, prefixListID)
+	if err != nil {
+		return err
+	}
+
+	// remove rules first, their index may be reused by a new rule
+	for _, rule := range existing {
+		if _, ok := configured[rule.Index]; ok {
+			continue
+		}
+		if err := netboxRawRequest(api, "DELETE", fmt.Sprintf("%s/prefix-list-rule/%d/", bgpPluginPath, rule.ID), nil, nil, nil); err != nil {
+			return fmt.Errorf("error deleting rule %d of prefix list %d: %w", rule.Index, prefixListID, err)
+		}
+	}
+
+	indexes := make([]int64, 0, len(configured))
+	for index := range configured {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+	for _, index := range indexes {
+		rule := configured[index]
+		i := sort.Search(len(existing), func(i int) bool { return existing[i].Index >= index })
+		if i < len(existing) && existing[i].Index == index {
+			if reflect.DeepEqual(existing[i].writable(), rule) {
+				continue
+			}
+			if err := netboxRawRequest(api, "PUT", fmt.Sprintf("%s/prefix-list-rule/%d/", bgpPluginPath, existing[i].ID), nil, rule, nil); err != nil {
+				return fmt.Errorf("error updating rule %d of prefix list %d: %w", index, prefixListID, err)
+			}
+			continue
+		}
+		if err := netboxRawRequest(api, "POST", bgpPluginPath+"/prefix-list-rule/", nil, rule, nil); err != nil {
+			return fmt.Errorf("error creating rule %d of prefix list %d: %w", index, prefixListID, err)
+		}
+	}
+	return nil
+}
+
+// flattenBGPPrefixListRules returns the rules in the form of the rule
+// attribute. Unset values are zero, like in the configuration.
+func flattenBGPPrefixListRules(rules []bgpPrefixListRule) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		writable := rule.writable()
+		flattenedRule := map[string]interface{}{
+			"index":       int(writable.Index),
+			"action":      writable.Action,
+			"prefix_id":   0,
+			"prefix":      "",
+			"ge":          0,
+			"le":          0,
+			"description": writable.Description,
+		}
+		if writable.Prefix != nil {
+			flattenedRule["prefix_id"] = int(*writable.Prefix)
+		}
+		if writable.PrefixCustom != nil {
+			flattenedRule["prefix"] = *writable.PrefixCustom
+		}
+		if writable.GE != nil {
+			flattenedRule["ge"] = int(*writable.GE)
+		}
+		if writable.LE != nil {
+			flattenedRule["le"] = int(*writable.LE)
+		}
+		flattened = append(flattened, flattenedRule)
+	}
+	return flattened
+}
+
+func resourceNetboxBGPPrefixListCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getBGPPrefixListFromResourceData(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// validate the rules before anything is created
+	if _, err := getBGPPrefixListRulesFromResourceData(d, 0); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var res bgpPrefixList
+	if err := netboxRawRequest(api, "POST", bgpPluginPath+"/prefix-list/", nil, data, &res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	rules, _ := getBGPPrefixListRulesFromResourceData(d, res.ID)
+	if err := syncBGPPrefixListRules(api, res.ID, rules); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxBGPPrefixListRead(ctx, d, m)
+}
+
+func resourceNetboxBGPPrefixListRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	var prefixList bgpPrefixList
+	if err := netboxRawRequest(api, "GET", fmt.Sprintf("%s/prefix-list/%d/", bgpPluginPath, id), nil, nil, &prefixList); err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	rules, err := getBGPPrefixListRules(api, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", prefixList.Name)
+	d.Set("description", prefixList.Description)
+	if prefixList.Family != nil {
+		d.Set("family", prefixList.Family.Value)
+	}
+	d.Set("comments", prefixList.Comments)
+	d.Set("rule", flattenBGPPrefixListRules(rules))
+
+	api.readTags(d, prefixList.Tags)
+
+	return nil
+}
+
+func resourceNetboxBGPPrefixListUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	rules, err := getBGPPrefixListRulesFromResourceData(d, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "description", "family", "comments", tagsAllKey) {
+		data, err := getBGPPrefixListFromResourceData(api, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := netboxRawRequest(api, "PUT", fmt.Sprintf("%s/prefix-list/%d/", bgpPluginPath, id), nil, data, nil); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("rule") {
+		if err := syncBGPPrefixListRules(api, id, rules); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceNetboxBGPPrefixListRead(ctx, d, m)
+}
+
+func resourceNetboxBGPPrefixListDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	// the rules are deleted with the prefix list
+	err := netboxRawRequest(api, "DELETE", fmt.Sprintf("%s/prefix-list/%s/", bgpPluginPath, d.Id()), nil, nil, nil)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
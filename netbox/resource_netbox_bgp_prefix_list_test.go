@@ -0,0 +1,144 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// newBGPPrefixListTestServer returns a mock NetBox with the netbox-bgp plugin
+// that serves prefix list 5 with the given rules, and records the changes of
+// the rules.
+func newBGPPrefixListTestServer(t *testing.T, rules []map[string]interface{}) (*httptest.Server, *[]string) {
+	var changes []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/plugins/bgp/prefix-list/":
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": 5})
+		case r.Method == "GET" && r.URL.Path == "/api/plugins/bgp/prefix-list/5/":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":     5,
+				"name":   "customers-in",
+				"family": map[string]interface{}{"value": "ipv4", "label": "IPv4"},
+				"tags":   []interface{}{},
+			})
+		case r.Method == "GET" && r.URL.Path == "/api/plugins/bgp/prefix-list-rule/":
+			assert.Equal(t, "5", r.URL.Query().Get("prefix_list"))
+			json.NewEncoder(w).Encode(map[string]interface{}{"count": len(rules), "results": rules})
+		case r.Method == "DELETE" && strings.HasPrefix(r.URL.Path, "/api/plugins/bgp/prefix-list-rule/"):
+			changes = append(changes, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+			w.WriteHeader(http.StatusNoContent)
+		case strings.HasPrefix(r.URL.Path, "/api/plugins/bgp/prefix-list-rule/"):
+			var received map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+				t.Errorf("failed to decode rule request: %v", err)
+			}
+			assert.Equal(t, float64(5), received["prefix_list"])
+			changes = append(changes, fmt.Sprintf("%s %s %v", r.Method, r.URL.Path, received["index"]))
+			json.NewEncoder(w).Encode(received)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	return ts, &changes
+}
+
+func testBGPPrefixListRule(id, index int, prefix string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":            id,
+		"prefix_list":   map[string]interface{}{"id": 5, "name": "customers-in"},
+		"index":         index,
+		"action":        map[string]interface{}{"value": "permit", "label": "Permit"},
+		"prefix":        nil,
+		"prefix_custom": prefix,
+		"ge":            nil,
+		"le":            24,
+		"description":   "",
+	}
+}
+
+func TestSyncBGPPrefixListRules(t *testing.T) {
+	ts, changes := newBGPPrefixListTestServer(t, []map[string]interface{}{
+		testBGPPrefixListRule(100, 10, "192.0.2.0/24"),
+		testBGPPrefixListRule(101, 20, "198.51.100.0/24"),
+		testBGPPrefixListRule(102, 30, "203.0.113.0/24"),
+		// a rule of another prefix list, in case the filter is ignored
+		{"id": 200, "prefix_list": map[string]interface{}{"id": 6}, "index": 10},
+	})
+	defer ts.Close()
+
+	custom := func(prefix string) *string { return &prefix }
+	configured := map[int64]writableBGPPrefixListRule{
+		10: {PrefixList: 5, Index: 10, Action: "permit", PrefixCustom: custom("192.0.2.0/24"), LE: int64ToPtr(24)},
+		20: {PrefixList: 5, Index: 20, Action: "deny", PrefixCustom: custom("198.51.100.0/24"), LE: int64ToPtr(24)},
+		40: {PrefixList: 5, Index: 40, Action: "permit", Prefix: int64ToPtr(12)},
+	}
+
+	err := syncBGPPrefixListRules(testMockProviderState(t, ts.URL), 5, configured)
+	assert.NoError(t, err)
+	// unchanged rules are kept, removed rules are deleted first
+	assert.Equal(t, []string{
+		"DELETE /api/plugins/bgp/prefix-list-rule/102/",
+		"PUT /api/plugins/bgp/prefix-list-rule/101/ 20",
+		"POST /api/plugins/bgp/prefix-list-rule/ 40",
+	}, *changes)
+}
+
+func TestResourceNetboxBGPPrefixListCreate(t *testing.T) {
+	ts, changes := newBGPPrefixListTestServer(t, []map[string]interface{}{testBGPPrefixListRule(100, 10, "192.0.2.0/24")})
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().ResourcesMap["netbox_bgp_prefix_list"].Schema, map[string]interface{}{
+		"name":   "customers-in",
+		"family": "ipv4",
+		"rule": []interface{}{
+			map[string]interface{}{"index": 10, "action": "permit", "prefix": "192.0.2.0/24", "le": 24},
+		},
+	})
+
+	diags := resourceNetboxBGPPrefixListCreate(context.Background(), d, testMockProviderState(t, ts.URL))
+	if diags.HasError() {
+		t.Fatalf("resourceNetboxBGPPrefixListCreate returned error: %v", diags)
+	}
+
+	// the mock already has the rule
+	assert.Empty(t, *changes)
+	assert.Equal(t, "5", d.Id())
+	assert.Equal(t, "ipv4", d.Get("family"))
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"index":       10,
+		"action":      "permit",
+		"prefix_id":   0,
+		"prefix":      "192.0.2.0/24",
+		"ge":          0,
+		"le":          24,
+		"description": "",
+	}}, d.Get("rule").(*schema.Set).List())
+}
+
+func TestResourceNetboxBGPPrefixListCreate_invalidRule(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().ResourcesMap["netbox_bgp_prefix_list"].Schema, map[string]interface{}{
+		"name":   "customers-in",
+		"family": "ipv4",
+		"rule": []interface{}{
+			map[string]interface{}{"index": 10, "action": "permit", "prefix": "192.0.2.0/24", "prefix_id": 12},
+		},
+	})
+
+	// fails before any request is sent
+	diags := resourceNetboxBGPPrefixListCreate(context.Background(), d, &providerState{})
+	if assert.True(t, diags.HasError()) {
+		assert.Equal(t, "rule 10: exactly one of prefix_id and prefix must be given", diags[0].Summary)
+	}
+}
@@ -0,0 +1,162 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// bgpPluginPath is the API path of the netbox-bgp plugin, relative to the
+// API base path.
+const bgpPluginPath = "/plugins/bgp"
+
+type bgpRoutingPolicy struct {
+	ID          int64               `json:"id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Weight      int64               `json:"weight"`
+	Comments    string              `json:"comments"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+type writableBGPRoutingPolicy struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Weight      *int64              `json:"weight,omitempty"`
+	Comments    string              `json:"comments"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+func resourceNetboxBGPRoutingPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxBGPRoutingPolicyCreate,
+		ReadContext:   resourceNetboxBGPRoutingPolicyRead,
+		UpdateContext: resourceNetboxBGPRoutingPolicyUpdate,
+		DeleteContext: resourceNetboxBGPRoutingPolicyDelete,
+
+		Description: `:meta:subcategory:Plugins:Manages a routing policy of the [netbox-bgp](https://github.com/netbox-community/netbox-bgp) plugin. Routing policies are applied to sessions and peer groups as import or export policies.
+
+This resource requires the netbox-bgp plugin.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"weight": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The order of the policy, defaults to the default weight of the plugin.",
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getBGPRoutingPolicyFromResourceData(api *providerState, d *schema.ResourceData) (*writableBGPRoutingPolicy, error) {
+	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return &writableBGPRoutingPolicy{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Weight:      getOptionalInt(d, "weight"),
+		Comments:    d.Get("comments").(string),
+		Tags:        tags,
+	}, nil
+}
+
+func resourceNetboxBGPRoutingPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getBGPRoutingPolicyFromResourceData(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var res bgpRoutingPolicy
+	if err := netboxRawRequest(api, "POST", bgpPluginPath+"/routing-policy/", nil, data, &res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxBGPRoutingPolicyRead(ctx, d, m)
+}
+
+func resourceNetboxBGPRoutingPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var policy bgpRoutingPolicy
+	if err := netboxRawRequest(api, "GET", fmt.Sprintf("%s/routing-policy/%s/", bgpPluginPath, d.Id()), nil, nil, &policy); err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("weight", policy.Weight)
+	d.Set("comments", policy.Comments)
+
+	api.readTags(d, policy.Tags)
+
+	return nil
+}
+
+func resourceNetboxBGPRoutingPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getBGPRoutingPolicyFromResourceData(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := netboxRawRequest(api, "PUT", fmt.Sprintf("%s/routing-policy/%s/", bgpPluginPath, d.Id()), nil, data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxBGPRoutingPolicyRead(ctx, d, m)
+}
+
+func resourceNetboxBGPRoutingPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	err := netboxRawRequest(api, "DELETE", fmt.Sprintf("%s/routing-policy/%s/", bgpPluginPath, d.Id()), nil, nil, nil)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxBGPSessionStatusOptions = []string{"active", "planned", "offline", "failed"}
+
+type bgpSession struct {
+	ID             int64               `json:"id"`
+	Name           string              `json:"name"`
+	Description    string              `json:"description"`
+	Status         *rawChoice          `json:"status"`
+	Site           *rawNestedObject    `json:"site"`
+	Tenant         *rawNestedObject    `json:"tenant"`
+	Device         *rawNestedObject    `json:"device"`
+	LocalAddress   *rawNestedObject    `json:"local_address"`
+	RemoteAddress  *rawNestedObject    `json:"remote_address"`
+	LocalAS        *rawNestedObject    `json:"local_as"`
+	RemoteAS       *rawNestedObject    `json:"remote_as"`
+	PeerGroup      *rawNestedObject    `json:"peer_group"`
+	ImportPolicies []rawNestedObject   `json:"import_policies"`
+	ExportPolicies []rawNestedObject   `json:"export_policies"`
+	PrefixListIn   *rawNestedObject    `json:"prefix_list_in"`
+	PrefixListOut  *rawNestedObject    `json:"prefix_list_out"`
+	Comments       string              `json:"comments"`
+	Tags           []*models.NestedTag `json:"tags"`
+}
+
+type writableBGPSession struct {
+	Name           string              `json:"name"`
+	Description    string              `json:"description"`
+	Status         string              `json:"status"`
+	Site           *int64              `json:"site"`
+	Tenant         *int64              `json:"tenant"`
+	Device         *int64              `json:"device"`
+	LocalAddress   int64               `json:"local_address"`
+	RemoteAddress  int64               `json:"remote_address"`
+	LocalAS        int64               `json:"local_as"`
+	RemoteAS       int64               `json:"remote_as"`
+	PeerGroup      *int64              `json:"peer_group"`
+	ImportPolicies []int64             `json:"import_policies"`
+	ExportPolicies []int64             `json:"export_policies"`
+	PrefixListIn   *int64              `json:"prefix_list_in"`
+	PrefixListOut  *int64              `json:"prefix_list_out"`
+	Comments       string              `json:"comments"`
+	Tags           []*models.NestedTag `json:"tags"`
+}
+
+func resourceNetboxBGPSession() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxBGPSessionCreate,
+		ReadContext:   resourceNetboxBGPSessionRead,
+		UpdateContext: resourceNetboxBGPSessionUpdate,
+		DeleteContext: resourceNetboxBGPSessionDelete,
+
+		Description: `:meta:subcategory:Plugins:Manages a BGP session of the [netbox-bgp](https://github.com/netbox-community/netbox-bgp) plugin. The addresses and AS numbers of the session reference objects managed with ` + "`netbox_ip_address`" + ` and ` + "`netbox_asn`" + `.
+
+This resource requires the netbox-bgp plugin.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice(resourceNetboxBGPSessionStatusOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxBGPSessionStatusOptions),
+			},
+			"site_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"device_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"local_address_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the local IP address.",
+			},
+			"remote_address_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the IP address of the peer.",
+			},
+			"local_as_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the local ASN.",
+			},
+			"remote_as_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the ASN of the peer.",
+			},
+			"peer_group_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"import_policy_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the routing policies applied to received routes.",
+			},
+			"export_policy_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the routing policies applied to advertised routes.",
+			},
+			"prefix_list_in_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"prefix_list_out_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getBGPSessionFromResourceData(api *providerState, d *schema.ResourceData) (*writableBGPSession, error) {
+	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return &writableBGPSession{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		Status:         d.Get("status").(string),
+		Site:           getOptionalInt(d, "site_id"),
+		Tenant:         getOptionalInt(d, "tenant_id"),
+		Device:         getOptionalInt(d, "device_id"),
+		LocalAddress:   int64(d.Get("local_address_id").(int)),
+		RemoteAddress:  int64(d.Get("remote_address_id").(int)),
+		LocalAS:        int64(d.Get("local_as_id").(int)),
+		RemoteAS:       int64(d.Get("remote_as_id").(int)),
+		PeerGroup:      getOptionalInt(d, "peer_group_id"),
+		ImportPolicies: toInt64List(d.Get("import_policy_ids")),
+		ExportPolicies: toInt64List(d.Get("export_policy_ids")),
+		PrefixListIn:   getOptionalInt(d, "prefix_list_in_id"),
+		PrefixListOut:  getOptionalInt(d, "prefix_list_out_id"),
+		Comments:       d.Get("comments").(string),
+		Tags:           tags,
+	}, nil
+}
+
+func resourceNetboxBGPSessionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getBGPSessionFromResourceData(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var res bgpSession
+	if err := netboxRawRequest(api, "POST", bgpPluginPath+"/session/", nil, data, &res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxBGPSessionRead(ctx, d, m)
+}
+
+func resourceNetboxBGPSessionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var session bgpSession
+	if err := netboxRawRequest(api, "GET", fmt.Sprintf("%s/session/%s/", bgpPluginPath, d.Id()), nil, nil, &session); err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	setBGPSessionAttributes(d, &session)
+	api.readTags(d, session.Tags)
+
+	return nil
+}
+
+// setBGPSessionAttributes sets the attributes shared by the resource and the
+// data source.
+func setBGPSessionAttributes(d *schema.ResourceData, session *bgpSession) {
+	d.Set("name", session.Name)
+	d.Set("description", session.Description)
+	if session.Status != nil {
+		d.Set("status", session.Status.Value)
+	}
+	d.Set("site_id", session.Site.idOrNil())
+	d.Set("tenant_id", session.Tenant.idOrNil())
+	d.Set("device_id", session.Device.idOrNil())
+	d.Set("local_address_id", session.LocalAddress.idOrNil())
+	d.Set("remote_address_id", session.RemoteAddress.idOrNil())
+	d.Set("local_as_id", session.LocalAS.idOrNil())
+	d.Set("remote_as_id", session.RemoteAS.idOrNil())
+	d.Set("peer_group_id", session.PeerGroup.idOrNil())
+	d.Set("import_policy_ids", getIDsFromRawNestedObjects(session.ImportPolicies))
+	d.Set("export_policy_ids", getIDsFromRawNestedObjects(session.ExportPolicies))
+	d.Set("prefix_list_in_id", session.PrefixListIn.idOrNil())
+	d.Set("prefix_list_out_id", session.PrefixListOut.idOrNil())
+	d.Set("comments", session.Comments)
+}
+
+func resourceNetboxBGPSessionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getBGPSessionFromResourceData(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := netboxRawRequest(api, "PUT", fmt.Sprintf("%s/session/%s/", bgpPluginPath, d.Id()), nil, data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxBGPSessionRead(ctx, d, m)
+}
+
+func resourceNetboxBGPSessionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	err := netboxRawRequest(api, "DELETE", fmt.Sprintf("%s/session/%s/", bgpPluginPath, d.Id()), nil, nil, nil)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceNetboxBGPSessionCreate(t *testing.T) {
+	var received map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		session := map[string]interface{}{
+			"id":              21,
+			"name":            "transit-a",
+			"status":          map[string]interface{}{"value": "planned", "label": "Planned"},
+			"site":            nil,
+			"device":          map[string]interface{}{"id": 4, "name": "edge-1"},
+			"local_address":   map[string]interface{}{"id": 30, "address": "192.0.2.1/31"},
+			"remote_address":  map[string]interface{}{"id": 31, "address": "192.0.2.0/31"},
+			"local_as":        map[string]interface{}{"id": 2, "asn": 64512},
+			"remote_as":       map[string]interface{}{"id": 3, "asn": 64513},
+			"peer_group":      nil,
+			"import_policies": []interface{}{map[string]interface{}{"id": 7, "name": "transit-in"}},
+			"export_policies": []interface{}{},
+			"prefix_list_in":  map[string]interface{}{"id": 5, "name": "customers-in"},
+			"prefix_list_out": nil,
+			"tags":            []interface{}{},
+		}
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/plugins/bgp/session/":
+			if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+				t.Errorf("failed to decode session request: %v", err)
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(session)
+		case r.Method == "GET" && r.URL.Path == "/api/plugins/bgp/session/21/":
+			json.NewEncoder(w).Encode(session)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().ResourcesMap["netbox_bgp_session"].Schema, map[string]interface{}{
+		"name":              "transit-a",
+		"status":            "planned",
+		"device_id":         4,
+		"local_address_id":  30,
+		"remote_address_id": 31,
+		"local_as_id":       2,
+		"remote_as_id":      3,
+		"import_policy_ids": []interface{}{7},
+		"prefix_list_in_id": 5,
+	})
+
+	diags := resourceNetboxBGPSessionCreate(context.Background(), d, testMockProviderState(t, ts.URL))
+	if diags.HasError() {
+		t.Fatalf("resourceNetboxBGPSessionCreate returned error: %v", diags)
+	}
+
+	assert.Equal(t, float64(30), received["local_address"])
+	assert.Equal(t, float64(3), received["remote_as"])
+	assert.Equal(t, []interface{}{float64(7)}, received["import_policies"])
+	assert.Equal(t, []interface{}{}, received["export_policies"])
+	assert.Nil(t, received["peer_group"])
+
+	assert.Equal(t, "21", d.Id())
+	assert.Equal(t, "planned", d.Get("status"))
+	assert.Equal(t, 4, d.Get("device_id"))
+	assert.Equal(t, 0, d.Get("site_id"))
+	assert.Equal(t, 31, d.Get("remote_address_id"))
+	assert.Equal(t, 5, d.Get("prefix_list_in_id"))
+	assert.Equal(t, []interface{}{7}, d.Get("import_policy_ids").(*schema.Set).List())
+}
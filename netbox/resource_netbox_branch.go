@@ -0,0 +1,330 @@
+package netbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// branchHeader selects the branch that requests are applied to.
+const branchHeader = "X-NetBox-Branch"
+
+var branchPendingStatuses = []string{"new", "provisioning", "syncing", "migrating", "merging", "reverting", "archiving"}
+var branchFinalStatuses = []string{"ready", "merged", "archived", "pending-migrations", "failed"}
+
+type branch struct {
+	ID          int64               `json:"id,omitempty"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	SchemaID    string              `json:"schema_id"`
+	Status      *rawChoice          `json:"status"`
+	LastSync    *string             `json:"last_sync"`
+	MergedTime  *string             `json:"merged_time"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+type writableBranch struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+type branchActionRequest struct {
+	Commit bool `json:"commit"`
+}
+
+func resourceNetboxBranch() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxBranchCreate,
+		ReadContext:   resourceNetboxBranchRead,
+		UpdateContext: resourceNetboxBranchUpdate,
+		DeleteContext: resourceNetboxBranchDelete,
+
+		Description: `:meta:subcategory:Plugins:From the [official documentation](https://netboxlabs.com/docs/extensions/branching/):
+
+> Branching enables users to make and review changes to NetBox data in isolation from the main database, and to merge them once they are ready.
+
+This resource requires the [netbox-branching](https://github.com/netboxlabs/netbox-branching) plugin. Use the ` + "`branch`" + ` provider setting to apply changes into a branch. Manage branches with a provider that is not configured for a branch itself.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"merged": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Set to `true` to merge the branch into main. Setting it back to `false` reverts the merge.",
+			},
+			"sync_triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that, when changed, synchronize the branch with the changes made in main since it was created or last synchronized.",
+			},
+			"schema_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The schema ID of the branch, as sent in the `X-NetBox-Branch` header.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_sync": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"merged_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			tagsKey: tagsSchema,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getBranch(api *providerState, id int64) (*branch, error) {
+	var b branch
+	if err := netboxRawRequest(api, "GET", fmt.Sprintf("/plugins/branching/branches/%d/", id), nil, nil, &b); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func getBranchByName(api *providerState, name string) (*branch, error) {
+	query := url.Values{}
+	query.Set("name", name)
+	query.Set("limit", "2")
+
+	var page rawListPage[branch]
+	if err := netboxRawRequest(api, "GET", "/plugins/branching/branches/", query, nil, &page); err != nil {
+		return nil, err
+	}
+
+	if page.Count > int64(1) {
+		return nil, errors.New("more than one branch returned, specify a more narrow filter")
+	}
+	if page.Count == int64(0) {
+		return nil, fmt.Errorf("no branch found with name %q", name)
+	}
+	return &page.Results[0], nil
+}
+
+func (b *branch) status() string {
+	if b.Status == nil {
+		return ""
+	}
+	return b.Status.Value
+}
+
+// waitForBranch waits until the branch has finished provisioning or the
+// current job, and returns the branch in its final state.
+func waitForBranch(ctx context.Context, api *providerState, id int64, timeout time.Duration) (*branch, error) {
+	conf := &retry.StateChangeConf{
+		Pending: branchPendingStatuses,
+		Target:  branchFinalStatuses,
+		Refresh: func() (interface{}, string, error) {
+			b, err := getBranch(api, id)
+			if err != nil {
+				return nil, "", err
+			}
+			return b, b.status(), nil
+		},
+		Timeout:      timeout,
+		PollInterval: jobPollInterval,
+	}
+
+	raw, err := conf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for branch %d: %w", id, err)
+	}
+	return raw.(*branch), nil
+}
+
+// runBranchAction runs a branch action (sync, merge or revert) and waits for
+// its job to finish.
+func runBranchAction(ctx context.Context, api *providerState, id int64, action string, timeout time.Duration) error {
+	var started scriptJob
+	if err := netboxRawRequest(api, "POST", fmt.Sprintf("/plugins/branching/branches/%d/%s/", id, action), nil, branchActionRequest{Commit: true}, &started); err != nil {
+		return fmt.Errorf("error starting %s of branch %d: %w", action, id, err)
+	}
+
+	conf := &retry.StateChangeConf{
+		Pending: scriptRunPendingStatuses,
+		Target:  scriptRunFinalStatuses,
+		Refresh: func() (interface{}, string, error) {
+			job, err := getScriptJob(api, started.ID)
+			if err != nil {
+				return nil, "", err
+			}
+			return job, job.status(), nil
+		},
+		Timeout:      timeout,
+		PollInterval: jobPollInterval,
+	}
+
+	raw, err := conf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for job %d to %s branch %d: %w", started.ID, action, id, err)
+	}
+
+	finished := raw.(*scriptJob)
+	if finished.status() != "completed" {
+		data, err := finished.data()
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("job %d to %s branch %d finished with status %q: %s", finished.ID, action, id, finished.status(), finished.failureMessage(data))
+	}
+	return nil
+}
+
+func getBranchFromResourceData(api *providerState, d *schema.ResourceData) (*writableBranch, error) {
+	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return &writableBranch{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Tags:        tags,
+	}, nil
+}
+
+func resourceNetboxBranchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getBranchFromResourceData(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var res branch
+	if err := netboxRawRequest(api, "POST", "/plugins/branching/branches/", nil, data, &res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	b, err := waitForBranch(ctx, api, res.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if b.status() != "ready" {
+		return diag.Errorf("provisioning branch %d finished with status %q", res.ID, b.status())
+	}
+
+	if d.Get("merged").(bool) {
+		if err := runBranchAction(ctx, api, res.ID, "merge", d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceNetboxBranchRead(ctx, d, m)
+}
+
+func resourceNetboxBranchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	b, err := getBranch(api, id)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", b.Name)
+	d.Set("description", b.Description)
+	d.Set("schema_id", b.SchemaID)
+	d.Set("status", b.status())
+	d.Set("merged", b.status() == "merged")
+	d.Set("last_sync", b.LastSync)
+	d.Set("merged_time", b.MergedTime)
+
+	api.readTags(d, b.Tags)
+
+	return nil
+}
+
+func resourceNetboxBranchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	if d.HasChanges("name", "description", tagsAllKey) {
+		data, err := getBranchFromResourceData(api, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := netboxRawRequest(api, "PUT", fmt.Sprintf("/plugins/branching/branches/%d/", id), nil, data, nil); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	merged := d.Get("merged").(bool)
+
+	// A merged branch cannot be synchronized anymore
+	if d.HasChange("sync_triggers") && !merged {
+		if err := runBranchAction(ctx, api, id, "sync", d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("merged") {
+		action := "revert"
+		if merged {
+			action = "merge"
+		}
+		if err := runBranchAction(ctx, api, id, action, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceNetboxBranchRead(ctx, d, m)
+}
+
+func resourceNetboxBranchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	err := netboxRawRequest(api, "DELETE", fmt.Sprintf("/plugins/branching/branches/%s/", d.Id()), nil, nil, nil)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// newBranchTestServer returns a mock NetBox with the branching plugin. Branch
+// 4 reports the given statuses, one per poll, and its jobs the given job
+// statuses.
+func newBranchTestServer(t *testing.T, statuses []string, jobStatuses []string) (*httptest.Server, *[]string) {
+	var actions []string
+	polls := 0
+	jobPolls := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		branch := map[string]interface{}{
+			"id":          4,
+			"name":        "review-123",
+			"description": "Changes of pipeline 123",
+			"schema_id":   "td5smq0f",
+			"tags":        []interface{}{},
+		}
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/plugins/branching/branches/":
+			var received map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+				t.Errorf("failed to decode branch request: %v", err)
+			}
+			assert.Equal(t, "review-123", received["name"])
+			branch["status"] = map[string]string{"value": "new"}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(branch)
+		case r.Method == "GET" && r.URL.Path == "/api/plugins/branching/branches/4/":
+			branch["status"] = map[string]string{"value": statuses[min(polls, len(statuses)-1)]}
+			polls++
+			json.NewEncoder(w).Encode(branch)
+		case r.Method == "POST" && (r.URL.Path == "/api/plugins/branching/branches/4/sync/" || r.URL.Path == "/api/plugins/branching/branches/4/merge/" || r.URL.Path == "/api/plugins/branching/branches/4/revert/"):
+			actions = append(actions, r.URL.Path)
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": 12, "status": map[string]string{"value": "pending"}})
+		case r.Method == "GET" && r.URL.Path == "/api/core/jobs/12/":
+			status := jobStatuses[min(jobPolls, len(jobStatuses)-1)]
+			jobPolls++
+			job := map[string]interface{}{"id": 12, "status": map[string]string{"value": status}}
+			if status == "errored" {
+				job["error"] = "merge conflict on dcim.site 7"
+			}
+			json.NewEncoder(w).Encode(job)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	return ts, &actions
+}
+
+func TestResourceNetboxBranchCreate(t *testing.T) {
+	ts, actions := newBranchTestServer(t, []string{"provisioning", "provisioning", "ready"}, nil)
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().ResourcesMap["netbox_branch"].Schema, map[string]interface{}{
+		"name":        "review-123",
+		"description": "Changes of pipeline 123",
+	})
+
+	diags := resourceNetboxBranchCreate(context.Background(), d, testMockProviderState(t, ts.URL))
+	if diags.HasError() {
+		t.Fatalf("resourceNetboxBranchCreate returned error: %v", diags)
+	}
+
+	assert.Empty(t, *actions)
+	assert.Equal(t, "4", d.Id())
+	assert.Equal(t, "td5smq0f", d.Get("schema_id"))
+	assert.Equal(t, "ready", d.Get("status"))
+	assert.Equal(t, false, d.Get("merged"))
+}
+
+func TestResourceNetboxBranchCreate_provisioningFailed(t *testing.T) {
+	ts, _ := newBranchTestServer(t, []string{"provisioning", "failed"}, nil)
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().ResourcesMap["netbox_branch"].Schema, map[string]interface{}{
+		"name": "review-123",
+	})
+
+	diags := resourceNetboxBranchCreate(context.Background(), d, testMockProviderState(t, ts.URL))
+	if assert.True(t, diags.HasError()) {
+		assert.Contains(t, diags[0].Summary, `provisioning branch 4 finished with status "failed"`)
+	}
+	// The branch exists, so it is tainted instead of being lost
+	assert.Equal(t, "4", d.Id())
+}
+
+func TestRunBranchAction(t *testing.T) {
+	ts, actions := newBranchTestServer(t, []string{"ready"}, []string{"pending", "running", "completed"})
+	defer ts.Close()
+
+	err := runBranchAction(context.Background(), testMockProviderState(t, ts.URL), 4, "merge", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/api/plugins/branching/branches/4/merge/"}, *actions)
+}
+
+func TestRunBranchAction_failed(t *testing.T) {
+	ts, _ := newBranchTestServer(t, []string{"ready"}, []string{"running", "errored"})
+	defer ts.Close()
+
+	err := runBranchAction(context.Background(), testMockProviderState(t, ts.URL), 4, "sync", time.Minute)
+	assert.ErrorContains(t, err, `job 12 to sync branch 4 finished with status "errored": merge conflict on dcim.site 7`)
+}
+
+func TestProviderConfigure_branch(t *testing.T) {
+	var branchHeaders []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/plugins/branching/branches/":
+			assert.Empty(t, r.Header.Get(branchHeader), "branches must be looked up in main")
+			assert.Equal(t, "review-123", r.URL.Query().Get("name"))
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"count":   1,
+				"results": []map[string]interface{}{{"id": 4, "name": "review-123", "schema_id": "td5smq0f", "status": map[string]string{"value": "ready"}}},
+			})
+		case "/api/dcim/sites/":
+			branchHeaders = append(branchHeaders, r.Header.Get(branchHeader))
+			json.NewEncoder(w).Encode(map[string]interface{}{"count": 0, "results": []interface{}{}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"server_url":         ts.URL,
+		"api_token":          "0123456789abcdef0123456789abcdef01234567",
+		"skip_version_check": true,
+		"branch":             "review-123",
+	})
+
+	state, diags := providerConfigure(context.Background(), d)
+	if diags.HasError() {
+		t.Fatalf("providerConfigure returned error: %v", diags)
+	}
+
+	err := netboxRawRequest(state.(*providerState), "GET", "/dcim/sites/", nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"td5smq0f"}, branchHeaders)
+}
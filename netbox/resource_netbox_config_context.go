@@ -37,9 +37,16 @@ func resourceNetboxConfigContext() *schema.Resource {
 			},
 			"data": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringIsJSON,
+				AtLeastOneOf: []string{"data", "data_file_path"},
+				Description:  "The context data as JSON object. Computed from the data file if `data_file_path` is set.",
 			},
+			"data_source_id":    syncedDataSourceIDSchema,
+			"data_file_path":    syncedDataFilePathSchema,
+			"auto_sync_enabled": syncedDataAutoSyncEnabledSchema,
+			"data_synced":       syncedDataSyncedSchema,
 			"cluster_groups": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -156,6 +163,8 @@ func resourceNetboxConfigContextCreate(d *schema.ResourceData, m interface{}) er
 	data := models.WritableConfigContext{}
 	data.Name = strToPtr(d.Get("name").(string))
 
+	// NetBox requires data, it is replaced with the content of the data file if one is set
+	data.Data = map[string]any{}
 	dataJSON, ok := d.GetOk("data")
 	if ok {
 		var jsonObj any
@@ -180,9 +189,14 @@ func resourceNetboxConfigContextCreate(d *schema.ResourceData, m interface{}) er
 	data.Tags = toStringList(d.Get(tagsAllKey))
 	data.Weight = int64ToPtr(int64(d.Get("weight").(int)))
 
+	syncedData, err := getSyncedDataOverrideFields(api, d)
+	if err != nil {
+		return err
+	}
+
 	params := extras.NewExtrasConfigContextsCreateParams().WithData(&data)
 
-	res, err := api.Extras.ExtrasConfigContextsCreate(params, nil)
+	res, err := api.Extras.ExtrasConfigContextsCreate(params, nil, hackSerializeWithValues(syncedData))
 	if err != nil {
 		//return errors.New(getTextFromError(err))
 		return err
@@ -198,7 +212,8 @@ func resourceNetboxConfigContextRead(d *schema.ResourceData, m interface{}) erro
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := extras.NewExtrasConfigContextsReadParams().WithID(id)
 
-	res, err := api.Extras.ExtrasConfigContextsRead(params, nil)
+	var syncedData rawSyncedData
+	res, err := api.Extras.ExtrasConfigContextsRead(params, nil, withRawResponse(&syncedData))
 
 	if err != nil {
 		if errresp, ok := err.(*extras.ExtrasConfigContextsReadDefault); ok {
@@ -222,6 +237,7 @@ func resourceNetboxConfigContextRead(d *schema.ResourceData, m interface{}) erro
 	} else {
 		d.Set("data", nil)
 	}
+	setSyncedDataResourceData(d, &syncedData)
 
 	clusterGroups := res.GetPayload().ClusterGroups
 	clusterGroupsSlice := make([]int64, len(clusterGroups))
@@ -329,6 +345,7 @@ func resourceNetboxConfigContextUpdate(d *schema.ResourceData, m interface{}) er
 	name := d.Get("name").(string)
 	data.Name = &name
 
+	data.Data = map[string]any{}
 	dataValue, ok := d.GetOk("data")
 	if ok {
 		var jsonObj any
@@ -353,9 +370,14 @@ func resourceNetboxConfigContextUpdate(d *schema.ResourceData, m interface{}) er
 	data.Tags = toStringList(d.Get(tagsAllKey))
 	data.Weight = int64ToPtr(int64(d.Get("weight").(int)))
 
+	syncedData, err := getSyncedDataOverrideFields(api, d)
+	if err != nil {
+		return err
+	}
+
 	params := extras.NewExtrasConfigContextsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Extras.ExtrasConfigContextsPartialUpdate(params, nil)
+	_, err = api.Extras.ExtrasConfigContextsPartialUpdate(params, nil, hackSerializeWithValues(syncedData))
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// configTemplateSyncedCodePlaceholder is sent as template code of templates
+// that are loaded from a data file, because the field is required by the API.
+const configTemplateSyncedCodePlaceholder = "{# synchronized from data file #}"
+
 func resourceNetboxConfigTemplate() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceNetboxConfigTemplateCreate,
@@ -35,8 +39,11 @@ func resourceNetboxConfigTemplate() *schema.Resource {
 				Optional: true,
 			},
 			"template_code": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"template_code", "data_file_path"},
+				Description:  "The Jinja2 template code. Computed from the data file if `data_file_path` is set.",
 			},
 			"environment_params": {
 				Type:         schema.TypeString,
@@ -44,7 +51,11 @@ func resourceNetboxConfigTemplate() *schema.Resource {
 				Default:      "{}",
 				ValidateFunc: validation.StringIsJSON,
 			},
-			tagsKey: tagsSchema,
+			"data_source_id":    syncedDataSourceIDSchema,
+			"data_file_path":    syncedDataFilePathSchema,
+			"auto_sync_enabled": syncedDataAutoSyncEnabledSchema,
+			"data_synced":       syncedDataSyncedSchema,
+			tagsKey:             tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -60,6 +71,10 @@ func resourceNetboxConfigTemplateCreate(ctx context.Context, d *schema.ResourceD
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	templateCode := d.Get("template_code").(string)
+	if templateCode == "" {
+		// NetBox requires template code, it is replaced with the content of the data file
+		templateCode = configTemplateSyncedCodePlaceholder
+	}
 
 	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
 
@@ -83,16 +98,21 @@ func resourceNetboxConfigTemplateCreate(ctx context.Context, d *schema.ResourceD
 		data.EnvironmentParams = environmentParams
 	}
 
+	syncedData, err := getSyncedDataOverrideFields(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	params := extras.NewExtrasConfigTemplatesCreateParams().WithData(&data)
 
-	res, err := api.Extras.ExtrasConfigTemplatesCreate(params, nil)
+	res, err := api.Extras.ExtrasConfigTemplatesCreate(params, nil, hackSerializeWithValues(syncedData))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return diags
+	return append(diags, resourceNetboxConfigTemplateRead(ctx, d, m)...)
 }
 
 func resourceNetboxConfigTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -103,7 +123,8 @@ func resourceNetboxConfigTemplateRead(ctx context.Context, d *schema.ResourceDat
 
 	params := extras.NewExtrasConfigTemplatesReadParams().WithID(id)
 
-	res, err := api.Extras.ExtrasConfigTemplatesRead(params, nil)
+	var syncedData rawSyncedData
+	res, err := api.Extras.ExtrasConfigTemplatesRead(params, nil, withRawResponse(&syncedData))
 	if err != nil {
 		if errresp, ok := err.(*extras.ExtrasConfigTemplatesReadDefault); ok {
 			errorcode := errresp.Code()
@@ -121,6 +142,7 @@ func resourceNetboxConfigTemplateRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("name", tmpl.Name)
 	d.Set("description", tmpl.Description)
 	d.Set("template_code", tmpl.TemplateCode)
+	setSyncedDataResourceData(d, &syncedData)
 
 	if tmpl.EnvironmentParams != nil {
 		environmentParamsJSON, err := json.Marshal(tmpl.EnvironmentParams)
@@ -146,6 +168,10 @@ func resourceNetboxConfigTemplateUpdate(ctx context.Context, d *schema.ResourceD
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	templateCode := d.Get("template_code").(string)
+	if templateCode == "" {
+		// NetBox requires template code, it is replaced with the content of the data file
+		templateCode = configTemplateSyncedCodePlaceholder
+	}
 
 	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
 
@@ -169,13 +195,18 @@ func resourceNetboxConfigTemplateUpdate(ctx context.Context, d *schema.ResourceD
 		data.EnvironmentParams = environmentParams
 	}
 
+	syncedData, err := getSyncedDataOverrideFields(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	params := extras.NewExtrasConfigTemplatesUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Extras.ExtrasConfigTemplatesUpdate(params, nil)
+	_, err = api.Extras.ExtrasConfigTemplatesUpdate(params, nil, hackSerializeWithValues(syncedData))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return diags
+	return append(diags, resourceNetboxConfigTemplateRead(ctx, d, m)...)
 }
 
 func resourceNetboxConfigTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
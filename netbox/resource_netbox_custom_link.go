@@ -0,0 +1,195 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxCustomLinkButtonClassOptions = []string{"outline-dark", "blue", "indigo", "purple", "pink", "red", "orange", "yellow", "green", "teal", "cyan", "gray", "black", "white", "ghost-dark"}
+
+func resourceNetboxCustomLink() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxCustomLinkCreate,
+		Read:   resourceNetboxCustomLinkRead,
+		Update: resourceNetboxCustomLinkUpdate,
+		Delete: resourceNetboxCustomLinkDelete,
+
+		Description: `:meta:subcategory:Extras:From the [official documentation](https://netboxlabs.com/docs/netbox/models/extras/customlink/):
+
+> Custom links allow users to display arbitrary hyperlinks to external content within NetBox object views. These are helpful for cross-referencing related records in systems outside NetBox. For example, you might create a custom link on the device view which links to the current device in a Network Monitoring System (NMS).
+>
+> Custom links are created by navigating to Customization > Custom Links. Each link has display text and a URL, and data from the NetBox item being viewed can be included in the link using Jinja2 template code.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"object_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The object types this link is displayed for, e.g. `dcim.device`.",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"link_text": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Jinja2 template code for the link text.",
+			},
+			"link_url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Jinja2 template code for the link URL.",
+			},
+			"weight": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
+			},
+			"group_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Links with the same group will appear as a dropdown menu.",
+			},
+			"button_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "outline-dark",
+				ValidateFunc: validation.StringInSlice(resourceNetboxCustomLinkButtonClassOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxCustomLinkButtonClassOptions),
+			},
+			"new_window": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Force the link to open in a new window.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getCustomLinkFromResourceData(d *schema.ResourceData) *models.CustomLink {
+	return &models.CustomLink{
+		Name:        strToPtr(d.Get("name").(string)),
+		Enabled:     d.Get("enabled").(bool),
+		LinkText:    strToPtr(d.Get("link_text").(string)),
+		LinkURL:     strToPtr(d.Get("link_url").(string)),
+		Weight:      int64ToPtr(int64(d.Get("weight").(int))),
+		GroupName:   d.Get("group_name").(string),
+		ButtonClass: d.Get("button_class").(string),
+		NewWindow:   d.Get("new_window").(bool),
+	}
+}
+
+// getCustomLinkOverrideFields returns the fields that are sent explicitly.
+// The go-netbox model only knows the pre-4.0 `content_types` and omits empty
+// values, which would prevent disabling a link or unsetting its group.
+func getCustomLinkOverrideFields(d *schema.ResourceData) map[string]any {
+	return map[string]any{
+		"object_types": toStringList(d.Get("object_types")),
+		"enabled":      d.Get("enabled").(bool),
+		"new_window":   d.Get("new_window").(bool),
+		"group_name":   d.Get("group_name").(string),
+	}
+}
+
+func resourceNetboxCustomLinkCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := getCustomLinkFromResourceData(d)
+
+	params := extras.NewExtrasCustomLinksCreateParams().WithData(data)
+
+	res, err := api.Extras.ExtrasCustomLinksCreate(params, nil, hackSerializeWithValues(getCustomLinkOverrideFields(d)))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxCustomLinkRead(d, m)
+}
+
+func resourceNetboxCustomLinkRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := extras.NewExtrasCustomLinksReadParams().WithID(id)
+
+	var objectTypes rawObjectTypes
+	res, err := api.Extras.ExtrasCustomLinksRead(params, nil, withRawResponse(&objectTypes))
+	if err != nil {
+		if errresp, ok := err.(*extras.ExtrasCustomLinksReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	link := res.GetPayload()
+	d.Set("name", link.Name)
+	d.Set("object_types", objectTypes.ObjectTypes)
+	d.Set("enabled", link.Enabled)
+	d.Set("link_text", link.LinkText)
+	d.Set("link_url", link.LinkURL)
+	d.Set("weight", link.Weight)
+	d.Set("group_name", link.GroupName)
+	d.Set("button_class", link.ButtonClass)
+	d.Set("new_window", link.NewWindow)
+
+	return nil
+}
+
+func resourceNetboxCustomLinkUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getCustomLinkFromResourceData(d)
+
+	params := extras.NewExtrasCustomLinksUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Extras.ExtrasCustomLinksUpdate(params, nil, hackSerializeWithValues(getCustomLinkOverrideFields(d)))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxCustomLinkRead(d, m)
+}
+
+func resourceNetboxCustomLinkDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := extras.NewExtrasCustomLinksDeleteParams().WithID(id)
+
+	_, err := api.Extras.ExtrasCustomLinksDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*extras.ExtrasCustomLinksDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
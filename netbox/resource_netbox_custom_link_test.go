@@ -0,0 +1,67 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxCustomLink_basic(t *testing.T) {
+	testName := testAccGetTestName("custom_link")
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_custom_link" "test" {
+  name         = "%[1]s"
+  object_types = ["dcim.device", "virtualization.virtualmachine"]
+  link_text    = "Monitoring"
+  link_url     = "https://nms.example.com/hosts/{{ object.name }}"
+  group_name   = "External"
+  button_class = "blue"
+  weight       = 50
+  new_window   = true
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_custom_link.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_custom_link.test", "object_types.#", "2"),
+					resource.TestCheckTypeSetElemAttr("netbox_custom_link.test", "object_types.*", "dcim.device"),
+					resource.TestCheckTypeSetElemAttr("netbox_custom_link.test", "object_types.*", "virtualization.virtualmachine"),
+					resource.TestCheckResourceAttr("netbox_custom_link.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("netbox_custom_link.test", "link_text", "Monitoring"),
+					resource.TestCheckResourceAttr("netbox_custom_link.test", "link_url", "https://nms.example.com/hosts/{{ object.name }}"),
+					resource.TestCheckResourceAttr("netbox_custom_link.test", "group_name", "External"),
+					resource.TestCheckResourceAttr("netbox_custom_link.test", "button_class", "blue"),
+					resource.TestCheckResourceAttr("netbox_custom_link.test", "weight", "50"),
+					resource.TestCheckResourceAttr("netbox_custom_link.test", "new_window", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_custom_link" "test" {
+  name         = "%[1]s"
+  object_types = ["dcim.device"]
+  enabled      = false
+  link_text    = "Monitoring"
+  link_url     = "https://nms.example.com/hosts/{{ object.name }}"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_custom_link.test", "object_types.#", "1"),
+					resource.TestCheckResourceAttr("netbox_custom_link.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("netbox_custom_link.test", "group_name", ""),
+					resource.TestCheckResourceAttr("netbox_custom_link.test", "button_class", "outline-dark"),
+					resource.TestCheckResourceAttr("netbox_custom_link.test", "weight", "100"),
+					resource.TestCheckResourceAttr("netbox_custom_link.test", "new_window", "false"),
+				),
+			},
+			{
+				ResourceName:      "netbox_custom_link.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
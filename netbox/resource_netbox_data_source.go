@@ -0,0 +1,255 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type coreDataSource struct {
+	ID          int64               `json:"id,omitempty"`
+	Name        string              `json:"name"`
+	Type        *rawChoice          `json:"type"`
+	SourceURL   string              `json:"source_url"`
+	Status      *rawChoice          `json:"status"`
+	Enabled     bool                `json:"enabled"`
+	Description string              `json:"description"`
+	Comments    string              `json:"comments"`
+	Parameters  any                 `json:"parameters"`
+	IgnoreRules string              `json:"ignore_rules"`
+	LastSynced  *string             `json:"last_synced"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+type writableCoreDataSource struct {
+	Name        string              `json:"name"`
+	Type        string              `json:"type"`
+	SourceURL   string              `json:"source_url"`
+	Enabled     bool                `json:"enabled"`
+	Description string              `json:"description"`
+	Comments    string              `json:"comments"`
+	Parameters  any                 `json:"parameters"`
+	IgnoreRules string              `json:"ignore_rules"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+func resourceNetboxDataSource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDataSourceCreate,
+		Read:   resourceNetboxDataSourceRead,
+		Update: resourceNetboxDataSourceUpdate,
+		Delete: resourceNetboxDataSourceDelete,
+
+		Description: `:meta:subcategory:Extras:From the [official documentation](https://netboxlabs.com/docs/netbox/models/core/datasource/):
+
+> A data source represents some external repository of data which NetBox can consume, such as a git repository. Files within the data source are synchronized to NetBox by saving them in the database as data file objects.
+
+Use the ` + "`netbox_data_source_sync`" + ` resource to synchronize a data source.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				// We do not enforce type validation, because plugins might add further backends
+				Description: "The backend type of the data source. By default, valid values are `local`, `git` and `amazon-s3`.",
+			},
+			"source_url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The URL of the data source, e.g. `https://github.com/example/configs.git` or `file:///opt/netbox/configs` for local paths.",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parameters": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsJSON,
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					equal, _ := jsonSemanticCompare(oldValue, newValue)
+					return equal
+				},
+				DiffSuppressOnRefresh: true,
+				Description:           "Backend-specific parameters as JSON object, e.g. `{\"username\": \"netbox\", \"password\": \"...\", \"branch\": \"main\"}` for git.",
+			},
+			"ignore_rules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Patterns of files to ignore when synchronizing, e.g. `*.txt`.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_synced": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getDataSourceFromResourceData(api *providerState, d *schema.ResourceData) (*writableCoreDataSource, error) {
+	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	ignoreRules := make([]string, 0)
+	for _, rule := range d.Get("ignore_rules").([]interface{}) {
+		ignoreRules = append(ignoreRules, rule.(string))
+	}
+
+	data := &writableCoreDataSource{
+		Name:        d.Get("name").(string),
+		Type:        d.Get("type").(string),
+		SourceURL:   d.Get("source_url").(string),
+		Enabled:     d.Get("enabled").(bool),
+		Description: d.Get("description").(string),
+		Comments:    d.Get("comments").(string),
+		IgnoreRules: strings.Join(ignoreRules, "\n"),
+		Tags:        tags,
+	}
+
+	if parameters, ok := d.GetOk("parameters"); ok {
+		if err := json.Unmarshal([]byte(parameters.(string)), &data.Parameters); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+func resourceNetboxDataSourceCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data, err := getDataSourceFromResourceData(api, d)
+	if err != nil {
+		return err
+	}
+
+	var res coreDataSource
+	err = netboxRawRequest(api, "POST", "/core/data-sources/", nil, data, &res)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxDataSourceRead(d, m)
+}
+
+func resourceNetboxDataSourceRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	var dataSource coreDataSource
+	err := netboxRawRequest(api, "GET", fmt.Sprintf("/core/data-sources/%s/", d.Id()), nil, nil, &dataSource)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("name", dataSource.Name)
+	d.Set("source_url", dataSource.SourceURL)
+	d.Set("enabled", dataSource.Enabled)
+	d.Set("description", dataSource.Description)
+	d.Set("comments", dataSource.Comments)
+	d.Set("last_synced", dataSource.LastSynced)
+
+	if dataSource.Type != nil {
+		d.Set("type", dataSource.Type.Value)
+	}
+	if dataSource.Status != nil {
+		d.Set("status", dataSource.Status.Value)
+	}
+
+	// NetBox returns an empty object if no parameters are set
+	if parameters, ok := dataSource.Parameters.(map[string]any); ok && len(parameters) == 0 {
+		d.Set("parameters", nil)
+	} else if dataSource.Parameters != nil {
+		parameters, err := json.Marshal(dataSource.Parameters)
+		if err != nil {
+			return err
+		}
+		d.Set("parameters", string(parameters))
+	} else {
+		d.Set("parameters", nil)
+	}
+
+	if dataSource.IgnoreRules != "" {
+		d.Set("ignore_rules", strings.Split(dataSource.IgnoreRules, "\n"))
+	} else {
+		d.Set("ignore_rules", nil)
+	}
+
+	api.readTags(d, dataSource.Tags)
+
+	return nil
+}
+
+func resourceNetboxDataSourceUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data, err := getDataSourceFromResourceData(api, d)
+	if err != nil {
+		return err
+	}
+
+	err = netboxRawRequest(api, "PUT", fmt.Sprintf("/core/data-sources/%s/", d.Id()), nil, data, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDataSourceRead(d, m)
+}
+
+func resourceNetboxDataSourceDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	err := netboxRawRequest(api, "DELETE", fmt.Sprintf("/core/data-sources/%s/", d.Id()), nil, nil, nil)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
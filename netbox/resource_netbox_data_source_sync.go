@@ -0,0 +1,157 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var dataSourceSyncPendingStatuses = []string{"new", "queued", "syncing"}
+var dataSourceSyncFinalStatuses = []string{"completed", "failed"}
+
+func resourceNetboxDataSourceSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxDataSourceSyncCreate,
+		ReadContext:   resourceNetboxDataSourceSyncRead,
+		DeleteContext: resourceNetboxDataSourceSyncDelete,
+
+		Description: `:meta:subcategory:Extras:Synchronizes a data source and waits for the sync job to finish.
+
+The data source is synchronized once on creation. To synchronize it again, change a value in ` + "`triggers_replace`" + `, e.g. the commit hash of the git repository. If the sync fails, the apply fails and the resource is tainted, so the sync is retried on the next apply.
+
+Sync jobs are executed by the NetBox background worker, which must be running for the job to finish.`,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"data_source_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers_replace": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Arbitrary values that, when changed, synchronize the data source again. Works like `triggers_replace` of the built-in `terraform_data` resource.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_synced": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getCoreDataSource(api *providerState, id int64) (*coreDataSource, error) {
+	var dataSource coreDataSource
+	if err := netboxRawRequest(api, "GET", fmt.Sprintf("/core/data-sources/%d/", id), nil, nil, &dataSource); err != nil {
+		return nil, err
+	}
+	return &dataSource, nil
+}
+
+func (ds *coreDataSource) status() string {
+	if ds.Status == nil {
+		return ""
+	}
+	return ds.Status.Value
+}
+
+// getDataSourceSyncError returns the error of the latest sync job of a data
+// source. It is best effort: the data source status does not carry details.
+func getDataSourceSyncError(api *providerState, id int64) string {
+	query := url.Values{}
+	query.Set("object_type", "core.datasource")
+	query.Set("object_id", strconv.FormatInt(id, 10))
+	query.Set("ordering", "-created")
+	query.Set("limit", "1")
+
+	var res rawListPage[scriptJob]
+	if err := netboxRawRequest(api, "GET", "/core/jobs/", query, nil, &res); err != nil || len(res.Results) == 0 || res.Results[0].Error == "" {
+		return "no error details were reported"
+	}
+	return res.Results[0].Error
+}
+
+func resourceNetboxDataSourceSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	dataSourceID := int64(d.Get("data_source_id").(int))
+
+	if err := netboxRawRequest(api, "POST", fmt.Sprintf("/core/data-sources/%d/sync/", dataSourceID), nil, nil, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(dataSourceID, 10))
+
+	conf := &retry.StateChangeConf{
+		Pending: dataSourceSyncPendingStatuses,
+		Target:  dataSourceSyncFinalStatuses,
+		Refresh: func() (interface{}, string, error) {
+			dataSource, err := getCoreDataSource(api, dataSourceID)
+			if err != nil {
+				return nil, "", err
+			}
+			return dataSource, dataSource.status(), nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		PollInterval: jobPollInterval,
+	}
+
+	raw, err := conf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for sync of data source %d: %s", dataSourceID, err)
+	}
+
+	dataSource := raw.(*coreDataSource)
+	d.Set("status", dataSource.status())
+	d.Set("last_synced", dataSource.LastSynced)
+
+	if dataSource.status() != "completed" {
+		return diag.Errorf("sync of data source %d failed: %s", dataSourceID, getDataSourceSyncError(api, dataSourceID))
+	}
+
+	return nil
+}
+
+func resourceNetboxDataSourceSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	dataSource, err := getCoreDataSource(api, id)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok && errresp.Code() == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("status", dataSource.status())
+	d.Set("last_synced", dataSource.LastSynced)
+
+	return nil
+}
+
+func resourceNetboxDataSourceSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// A sync cannot be undone, so destroying it only removes it from the state
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,81 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// newDataSourceSyncTestServer returns a mock NetBox that accepts a sync of
+// data source 3 and reports the given statuses, one per poll.
+func newDataSourceSyncTestServer(t *testing.T, statuses []string, jobError string) (*httptest.Server, *int) {
+	syncRequests := 0
+	polls := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/core/data-sources/3/sync/":
+			syncRequests++
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": 3, "status": map[string]string{"value": "queued"}})
+		case r.Method == "GET" && r.URL.Path == "/api/core/data-sources/3/":
+			status := statuses[min(polls, len(statuses)-1)]
+			polls++
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":          3,
+				"status":      map[string]string{"value": status},
+				"last_synced": "2024-05-01T10:00:05Z",
+			})
+		case r.Method == "GET" && r.URL.Path == "/api/core/jobs/":
+			assert.Equal(t, "core.datasource", r.URL.Query().Get("object_type"))
+			assert.Equal(t, "3", r.URL.Query().Get("object_id"))
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"count":   1,
+				"results": []map[string]interface{}{{"id": 9, "status": map[string]string{"value": "errored"}, "error": jobError}},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	return ts, &syncRequests
+}
+
+func TestResourceNetboxDataSourceSyncCreate_completed(t *testing.T) {
+	ts, syncRequests := newDataSourceSyncTestServer(t, []string{"queued", "syncing", "completed"}, "")
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceNetboxDataSourceSync().Schema, map[string]interface{}{
+		"data_source_id": 3,
+	})
+
+	diags := resourceNetboxDataSourceSyncCreate(context.Background(), d, testMockProviderState(t, ts.URL))
+	if diags.HasError() {
+		t.Fatalf("resourceNetboxDataSourceSyncCreate returned error: %v", diags)
+	}
+
+	assert.Equal(t, 1, *syncRequests)
+	assert.Equal(t, "3", d.Id())
+	assert.Equal(t, "completed", d.Get("status"))
+	assert.Equal(t, "2024-05-01T10:00:05Z", d.Get("last_synced"))
+}
+
+func TestResourceNetboxDataSourceSyncCreate_failed(t *testing.T) {
+	ts, _ := newDataSourceSyncTestServer(t, []string{"syncing", "failed"}, "git clone failed: repository not found")
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceNetboxDataSourceSync().Schema, map[string]interface{}{
+		"data_source_id": 3,
+	})
+
+	diags := resourceNetboxDataSourceSyncCreate(context.Background(), d, testMockProviderState(t, ts.URL))
+	if assert.True(t, diags.HasError(), "expected a failed sync to fail the apply") {
+		assert.Contains(t, diags[0].Summary, "git clone failed: repository not found")
+	}
+	assert.Equal(t, "failed", d.Get("status"))
+}
@@ -0,0 +1,68 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxDataSource_basic(t *testing.T) {
+	testName := testAccGetTestName("data_source")
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_data_source" "test" {
+  name        = "%[1]s"
+  type        = "local"
+  source_url  = "file:///tmp/%[1]s"
+  description = "%[1]s description"
+  comments    = "%[1]s comments"
+  tags        = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_data_source.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "type", "local"),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "source_url", "file:///tmp/"+testName),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "description", testName+" description"),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "comments", testName+" comments"),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "ignore_rules.#", "0"),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "status", "new"),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "tags.#", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_data_source" "test" {
+  name         = "%[1]s"
+  type         = "local"
+  source_url   = "file:///tmp/%[1]s"
+  enabled      = false
+  ignore_rules = ["*.txt", "drafts/*"]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_data_source.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "comments", ""),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "ignore_rules.#", "2"),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "ignore_rules.0", "*.txt"),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "ignore_rules.1", "drafts/*"),
+					resource.TestCheckResourceAttr("netbox_data_source.test", "tags.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_data_source.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
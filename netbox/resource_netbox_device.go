@@ -133,6 +133,11 @@ func resourceNetboxDevice() *schema.Resource {
 				Optional:    true,
 				Description: "This is best managed through the use of `jsonencode` and a map of settings.",
 			},
+			"config_context": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The config context data of this device as JSON, merged from all applicable config contexts and the local context data.",
+			},
 			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
@@ -403,6 +408,14 @@ func resourceNetboxDeviceRead(ctx context.Context, d *schema.ResourceData, m int
 		d.Set("local_context_data", nil)
 	}
 
+	if device.ConfigContext != nil {
+		if jsonArr, err := json.Marshal(device.ConfigContext); err == nil {
+			d.Set("config_context", string(jsonArr))
+		}
+	} else {
+		d.Set("config_context", nil)
+	}
+
 	api.readTags(d, device.Tags)
 	return diags
 }
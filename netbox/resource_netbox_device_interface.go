@@ -2,7 +2,9 @@ package netbox
 
 import (
 	"context"
+	"maps"
 	"strconv"
+	"strings"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
@@ -12,6 +14,66 @@ import (
 )
 
 var resourceNetboxDeviceInterfaceModeOptions = []string{"access", "tagged", "tagged-all", "q-in-q"}
+var resourceNetboxDeviceInterfaceDuplexOptions = []string{"half", "full", "auto"}
+var resourceNetboxDeviceInterfacePoeModeOptions = []string{"pd", "pse"}
+var resourceNetboxDeviceInterfacePoeTypeOptions = []string{"type1-ieee802.3af", "type2-ieee802.3at", "type2-ieee802.3az", "type3-ieee802.3bt", "type4-ieee802.3bt", "passive-24v-2pair", "passive-24v-4pair", "passive-48v-2pair", "passive-48v-4pair"}
+var resourceNetboxDeviceInterfaceRfRoleOptions = []string{"ap", "station"}
+
+// Wireless channels as defined by NetBox, named <band>-<channel>-<frequency>-<width>
+var resourceNetboxDeviceInterfaceRfChannelOptions = []string{
+	"2.4g-1-2412-22", "2.4g-2-2417-22", "2.4g-3-2422-22", "2.4g-4-2427-22", "2.4g-5-2432-22",
+	"2.4g-6-2437-22", "2.4g-7-2442-22", "2.4g-8-2447-22", "2.4g-9-2452-22", "2.4g-10-2457-22",
+	"2.4g-11-2462-22", "2.4g-12-2467-22", "2.4g-13-2472-22",
+	"5g-32-5160-20", "5g-34-5170-40", "5g-36-5180-20", "5g-38-5190-40", "5g-40-5200-20",
+	"5g-42-5210-80", "5g-44-5220-20", "5g-46-5230-40", "5g-48-5240-20", "5g-50-5250-160",
+	"5g-52-5260-20", "5g-54-5270-40", "5g-56-5280-20", "5g-58-5290-80", "5g-60-5300-20",
+	"5g-62-5310-40", "5g-64-5320-20", "5g-100-5500-20", "5g-102-5510-40", "5g-104-5520-20",
+	"5g-106-5530-80", "5g-108-5540-20", "5g-110-5550-40", "5g-112-5560-20", "5g-114-5570-160",
+	"5g-116-5580-20", "5g-118-5590-40", "5g-120-5600-20", "5g-122-5610-80", "5g-124-5620-20",
+	"5g-126-5630-40", "5g-128-5640-20", "5g-132-5660-20", "5g-134-5670-40", "5g-136-5680-20",
+	"5g-138-5690-80", "5g-140-5700-20", "5g-142-5710-40", "5g-144-5720-20", "5g-149-5745-20",
+	"5g-151-5755-40", "5g-153-5765-20", "5g-155-5775-80", "5g-157-5785-20", "5g-159-5795-40",
+	"5g-161-5805-20", "5g-163-5815-160", "5g-165-5825-20", "5g-167-5835-40", "5g-169-5845-20",
+	"5g-171-5855-80", "5g-173-5865-20", "5g-175-5875-40", "5g-177-5885-20",
+	"6g-1-5955-20", "6g-3-5965-40", "6g-5-5975-20", "6g-7-5985-80", "6g-9-5995-20", "6g-11-6005-40",
+	"6g-13-6015-20", "6g-15-6025-160", "6g-17-6035-20", "6g-19-6045-40", "6g-21-6055-20",
+	"6g-23-6065-80", "6g-25-6075-20", "6g-27-6085-40", "6g-29-6095-20", "6g-31-6105-320",
+	"6g-33-6115-20", "6g-35-6125-40", "6g-37-6135-20", "6g-39-6145-80", "6g-41-6155-20",
+	"6g-43-6165-40", "6g-45-6175-20", "6g-47-6185-160", "6g-49-6195-20", "6g-51-6205-40",
+	"6g-53-6215-20", "6g-55-6225-80", "6g-57-6235-20", "6g-59-6245-40", "6g-61-6255-20",
+	"6g-65-6275-20", "6g-67-6285-40", "6g-69-6295-20", "6g-71-6305-80", "6g-73-6315-20",
+	"6g-75-6325-40", "6g-77-6335-20", "6g-79-6345-160", "6g-81-6355-20", "6g-83-6365-40",
+	"6g-85-6375-20", "6g-87-6385-80", "6g-89-6395-20", "6g-91-6405-40", "6g-93-6415-20",
+	"6g-95-6425-320", "6g-97-6435-20", "6g-99-6445-40", "6g-101-6455-20", "6g-103-6465-80",
+	"6g-105-6475-20", "6g-107-6485-40", "6g-109-6495-20", "6g-111-6505-160", "6g-113-6515-20",
+	"6g-115-6525-40", "6g-117-6535-20", "6g-119-6545-80", "6g-121-6555-20", "6g-123-6565-40",
+	"6g-125-6575-20", "6g-129-6595-20", "6g-131-6605-40", "6g-133-6615-20", "6g-135-6625-80",
+	"6g-137-6635-20", "6g-139-6645-40", "6g-141-6655-20", "6g-143-6665-160", "6g-145-6675-20",
+	"6g-147-6685-40", "6g-149-6695-20", "6g-151-6705-80", "6g-153-6715-20", "6g-155-6725-40",
+	"6g-157-6735-20", "6g-159-6745-320", "6g-161-6755-20", "6g-163-6765-40", "6g-165-6775-20",
+	"6g-167-6785-80", "6g-169-6795-20", "6g-171-6805-40", "6g-173-6815-20", "6g-175-6825-160",
+	"6g-177-6835-20", "6g-179-6845-40", "6g-181-6855-20", "6g-183-6865-80", "6g-185-6875-20",
+	"6g-187-6885-40", "6g-189-6895-20", "6g-193-6915-20", "6g-195-6925-40", "6g-197-6935-20",
+	"6g-199-6945-80", "6g-201-6955-20", "6g-203-6965-40", "6g-205-6975-20", "6g-207-6985-160",
+	"6g-209-6995-20", "6g-211-7005-40", "6g-213-7015-20", "6g-215-7025-80", "6g-217-7035-20",
+	"6g-219-7045-40", "6g-221-7055-20", "6g-225-7075-20", "6g-227-7085-40", "6g-229-7095-20",
+	"6g-233-7115-20",
+	"60g-1-58320-2160", "60g-2-60480-2160", "60g-3-62640-2160", "60g-4-64800-2160",
+	"60g-5-66960-2160", "60g-6-69120-2160", "60g-9-59400-4320", "60g-10-61560-4320",
+	"60g-11-63720-4320", "60g-12-65880-4320", "60g-13-68040-4320", "60g-17-60480-6480",
+	"60g-18-62640-6480", "60g-19-64800-6480", "60g-20-66960-6480", "60g-25-61560-6480",
+	"60g-26-63720-6480", "60g-27-65880-6480",
+}
+
+// Choice attributes of device interfaces that are unset by sending an empty string
+var deviceInterfaceChoiceFields = []string{"duplex", "poe_mode", "poe_type", "rf_role", "rf_channel"}
+
+// Attributes of device interfaces that are unset by sending null
+var deviceInterfaceNullableFields = map[string]string{
+	"lag_device_interface_id": "lag",
+	"wwn":                     "wwn",
+	"tx_power":                "tx_power",
+}
 
 func resourceNetboxDeviceInterface() *schema.Resource {
 	return &schema.Resource{
@@ -105,8 +167,86 @@ func resourceNetboxDeviceInterface() *schema.Resource {
 				Description: "The netbox_device_interface id of the parent interface. Useful if this interface is a logical interface.",
 			},
 			"speed": {
-				Type:     schema.TypeInt,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Speed in Kbps.",
+			},
+			"duplex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceInterfaceDuplexOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceInterfaceDuplexOptions),
+			},
+			"wwn": {
+				Type:     schema.TypeString,
 				Optional: true,
+				// Netbox converts WWNs always to uppercase
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+				Description: "The 64-bit World Wide Name of this interface, for Fibre Channel interfaces.",
+			},
+			"mark_connected": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"poe_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceInterfacePoeModeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceInterfacePoeModeOptions),
+			},
+			"poe_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceInterfacePoeTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceInterfacePoeTypeOptions),
+			},
+			"rf_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceInterfaceRfRoleOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceInterfaceRfRoleOptions),
+			},
+			"rf_channel": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceInterfaceRfChannelOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceInterfaceRfChannelOptions),
+			},
+			"rf_channel_frequency": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Computed:    true,
+				Description: "Channel frequency in MHz. Populated by NetBox from `rf_channel` if not set.",
+			},
+			"rf_channel_width": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Computed:    true,
+				Description: "Channel width in MHz. Populated by NetBox from `rf_channel` if not set.",
+			},
+			"tx_power": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 127),
+				Description:  "Transmit power in dBm.",
+			},
+			"wireless_lan_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"vdc_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of the virtual device contexts this interface is assigned to.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -124,6 +264,15 @@ func resourceNetboxDeviceInterface() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"qinq_svlan_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the service VLAN (SVLAN) of this interface. Only valid when `mode` is `q-in-q`.",
+			},
+			"vlan_translation_policy_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
 			"vrf_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -165,12 +314,10 @@ func resourceNetboxDeviceInterfaceCreate(ctx context.Context, d *schema.Resource
 		Tags:         tags,
 		TaggedVlans:  taggedVlans,
 		Device:       &deviceID,
-		WirelessLans: []int64{},
-		Vdcs:         []int64{},
-	}
-	if lag, ok := d.Get("lag_device_interface_id").(int); ok && lag != 0 {
-		data.Lag = int64ToPtr(int64(lag))
+		WirelessLans: toInt64List(d.Get("wireless_lan_ids")),
+		Vdcs:         toInt64List(d.Get("vdc_ids")),
 	}
+	setDeviceInterfaceOptionalFields(&data, d)
 	if mtu, ok := d.Get("mtu").(int); ok && mtu != 0 {
 		data.Mtu = int64ToPtr(int64(mtu))
 	}
@@ -188,7 +335,7 @@ func resourceNetboxDeviceInterfaceCreate(ctx context.Context, d *schema.Resource
 
 	params := dcim.NewDcimInterfacesCreateParams().WithData(&data)
 
-	res, err := api.Dcim.DcimInterfacesCreate(params, nil)
+	res, err := api.Dcim.DcimInterfacesCreate(params, nil, hackSerializeWithValues(getInterfaceVlanFields(d, false)))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -206,7 +353,8 @@ func resourceNetboxDeviceInterfaceRead(ctx context.Context, d *schema.ResourceDa
 
 	params := dcim.NewDcimInterfacesReadParams().WithID(id)
 
-	res, err := api.Dcim.DcimInterfacesRead(params, nil)
+	var vlanFields interfaceVlanFields
+	res, err := api.Dcim.DcimInterfacesRead(params, nil, withRawResponse(&vlanFields))
 	if err != nil {
 		if errresp, ok := err.(*dcim.DcimInterfacesReadDefault); ok {
 			errorcode := errresp.Code()
@@ -241,7 +389,51 @@ func resourceNetboxDeviceInterfaceRead(ctx context.Context, d *schema.ResourceDa
 
 	if iface.Lag != nil {
 		d.Set("lag_device_interface_id", iface.Lag.ID)
+	} else {
+		d.Set("lag_device_interface_id", nil)
+	}
+	d.Set("mark_connected", iface.MarkConnected)
+	d.Set("wwn", iface.Wwn)
+	d.Set("rf_channel_frequency", iface.RfChannelFrequency)
+	d.Set("rf_channel_width", iface.RfChannelWidth)
+	d.Set("tx_power", iface.TxPower)
+	if iface.Duplex != nil && iface.Duplex.Value != nil {
+		d.Set("duplex", iface.Duplex.Value)
+	} else {
+		d.Set("duplex", nil)
+	}
+	if iface.PoeMode != nil && iface.PoeMode.Value != nil {
+		d.Set("poe_mode", iface.PoeMode.Value)
+	} else {
+		d.Set("poe_mode", nil)
+	}
+	if iface.PoeType != nil && iface.PoeType.Value != nil {
+		d.Set("poe_type", iface.PoeType.Value)
+	} else {
+		d.Set("poe_type", nil)
+	}
+	if iface.RfRole != nil && iface.RfRole.Value != nil {
+		d.Set("rf_role", iface.RfRole.Value)
+	} else {
+		d.Set("rf_role", nil)
+	}
+	if iface.RfChannel != nil && iface.RfChannel.Value != nil {
+		d.Set("rf_channel", iface.RfChannel.Value)
+	} else {
+		d.Set("rf_channel", nil)
+	}
+
+	var wirelessLanIDs []int64
+	for _, wlan := range iface.WirelessLans {
+		wirelessLanIDs = append(wirelessLanIDs, wlan.ID)
 	}
+	d.Set("wireless_lan_ids", wirelessLanIDs)
+
+	var vdcIDs []int64
+	for _, vdc := range iface.Vdcs {
+		vdcIDs = append(vdcIDs, vdc.ID)
+	}
+	d.Set("vdc_ids", vdcIDs)
 	if iface.Mode != nil {
 		d.Set("mode", iface.Mode.Value)
 	}
@@ -274,6 +466,7 @@ func resourceNetboxDeviceInterfaceRead(ctx context.Context, d *schema.ResourceDa
 	if iface.PrimaryMacAddress != nil {
 		d.Set("primary_mac_address_id", iface.PrimaryMacAddress.ID)
 	}
+	vlanFields.readInto(d)
 
 	return diags
 }
@@ -310,15 +503,11 @@ func resourceNetboxDeviceInterfaceUpdate(ctx context.Context, d *schema.Resource
 		Tags:         tags,
 		TaggedVlans:  taggedVlans,
 		Device:       &deviceID,
-		WirelessLans: []int64{},
-		Vdcs:         []int64{},
+		WirelessLans: toInt64List(d.Get("wireless_lan_ids")),
+		Vdcs:         toInt64List(d.Get("vdc_ids")),
 	}
 	data.Module = getOptionalInt(d, "module_id")
-
-	if d.HasChange("lag_device_interface_id") {
-		lag := int64(d.Get("lag_device_interface_id").(int))
-		data.Lag = &lag
-	}
+	setDeviceInterfaceOptionalFields(&data, d)
 	if d.HasChange("mtu") {
 		mtu := int64(d.Get("mtu").(int))
 		data.Mtu = &mtu
@@ -343,8 +532,11 @@ func resourceNetboxDeviceInterfaceUpdate(ctx context.Context, d *schema.Resource
 		data.Vrf = getOptionalInt(d, "vrf_id")
 	}
 
+	overrideFields := getInterfaceVlanFields(d, true)
+	maps.Copy(overrideFields, getDeviceInterfaceClearedFields(d))
+
 	params := dcim.NewDcimInterfacesPartialUpdateParams().WithID(id).WithData(&data)
-	_, err = api.Dcim.DcimInterfacesPartialUpdate(params, nil)
+	_, err = api.Dcim.DcimInterfacesPartialUpdate(params, nil, hackSerializeWithValues(overrideFields))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -371,6 +563,69 @@ func resourceNetboxDeviceInterfaceDelete(ctx context.Context, d *schema.Resource
 	return nil
 }
 
+// setDeviceInterfaceOptionalFields sets the optional attributes that are
+// handled the same way on create and update.
+func setDeviceInterfaceOptionalFields(data *models.WritableInterface, d *schema.ResourceData) {
+	data.MarkConnected = d.Get("mark_connected").(bool)
+	data.PoeMode = d.Get("poe_mode").(string)
+	data.PoeType = d.Get("poe_type").(string)
+	data.RfRole = d.Get("rf_role").(string)
+	data.RfChannel = d.Get("rf_channel").(string)
+	data.Lag = getOptionalInt(d, "lag_device_interface_id")
+	// A transmit power of 0 is valid, so it cannot be told apart from an unset
+	// value with GetOk
+	if !isDeviceInterfaceConfigNull(d, "tx_power") {
+		data.TxPower = int64ToPtr(int64(d.Get("tx_power").(int)))
+	}
+	data.RfChannelFrequency = getOptionalFloat(d, "rf_channel_frequency")
+	data.RfChannelWidth = getOptionalFloat(d, "rf_channel_width")
+	if duplex, ok := d.GetOk("duplex"); ok {
+		data.Duplex = strToPtr(duplex.(string))
+	}
+	if wwn, ok := d.GetOk("wwn"); ok {
+		data.Wwn = strToPtr(wwn.(string))
+	}
+}
+
+// getDeviceInterfaceClearedFields returns the attributes that were removed
+// from the configuration. The go-netbox model omits empty values, so they
+// have to be sent explicitly to be unset on a partial update.
+func getDeviceInterfaceClearedFields(d *schema.ResourceData) map[string]any {
+	fields := make(map[string]any)
+	for _, key := range deviceInterfaceChoiceFields {
+		if _, ok := d.GetOk(key); !ok && d.HasChange(key) {
+			fields[key] = ""
+		}
+	}
+	for key, apiField := range deviceInterfaceNullableFields {
+		if isDeviceInterfaceConfigNull(d, key) && d.HasChange(key) {
+			fields[apiField] = nil
+		}
+	}
+	// NetBox only fills in the channel frequency and width if they are empty,
+	// so the values of the previous channel have to be unset whenever the
+	// channel changes
+	if d.HasChange("rf_channel") {
+		for _, key := range []string{"rf_channel_frequency", "rf_channel_width"} {
+			if isDeviceInterfaceConfigNull(d, key) {
+				fields[key] = nil
+			}
+		}
+	}
+	return fields
+}
+
+// isDeviceInterfaceConfigNull returns whether the attribute is missing from
+// the configuration. Unlike GetOk, zero values count as set.
+func isDeviceInterfaceConfigNull(d *schema.ResourceData, key string) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		_, ok := d.GetOk(key)
+		return !ok
+	}
+	return rawConfig.GetAttr(key).IsNull()
+}
+
 func getIDsFromNestedVLANDevice(nestedvlans []*models.NestedVLAN) []int64 {
 	var vlans []int64
 	for _, vlan := range nestedvlans {
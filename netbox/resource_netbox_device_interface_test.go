@@ -311,6 +311,195 @@ func TestAccNetboxDeviceInterface_vlans(t *testing.T) {
 	})
 }
 
+func testAccNetboxDeviceInterfaceQinQ(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_vlan" "svlan" {
+  name      = "%[1]s_svlan"
+  vid       = 1003
+  qinq_role = "svlan"
+}
+
+resource "netbox_vlan_translation_policy" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_interface" "test" {
+  name                       = "%[1]s"
+  mode                       = "q-in-q"
+  qinq_svlan_id              = netbox_vlan.svlan.id
+  vlan_translation_policy_id = netbox_vlan_translation_policy.test.id
+  device_id                  = netbox_device.test.id
+  type                       = "1000base-t"
+}`, testName)
+}
+
+func TestAccNetboxDeviceInterface_qinq(t *testing.T) {
+	testSlug := "iface_qinq"
+	testName := testAccGetTestName(testSlug)
+	setUp := testAccNetboxDeviceInterfaceFullDependencies(testName)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDeviceInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: setUp + testAccNetboxDeviceInterfaceQinQ(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "mode", "q-in-q"),
+					resource.TestCheckResourceAttrPair("netbox_device_interface.test", "qinq_svlan_id", "netbox_vlan.svlan", "id"),
+					resource.TestCheckResourceAttrPair("netbox_device_interface.test", "vlan_translation_policy_id", "netbox_vlan_translation_policy.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_device_interface.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNetboxDeviceInterfaceWireless(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_wireless_lan" "test" {
+  ssid = "%[1]s"
+}
+
+resource "netbox_device_interface" "lag" {
+  name      = "%[1]s_lag"
+  device_id = netbox_device.test.id
+  type      = "lag"
+}
+
+resource "netbox_device_interface" "poe" {
+  name                    = "%[1]s_poe"
+  device_id               = netbox_device.test.id
+  type                    = "1000base-t"
+  lag_device_interface_id = netbox_device_interface.lag.id
+  speed                   = 1000000
+  duplex                  = "full"
+  mark_connected          = true
+  poe_mode                = "pse"
+  poe_type                = "type2-ieee802.3at"
+}
+
+resource "netbox_device_interface" "radio" {
+  name             = "%[1]s_radio"
+  device_id        = netbox_device.test.id
+  type             = "ieee802.11ac"
+  rf_role          = "ap"
+  rf_channel       = "5g-36-5180-20"
+  tx_power         = 20
+  wireless_lan_ids = [netbox_wireless_lan.test.id]
+}
+
+resource "netbox_device_interface" "fc" {
+  name      = "%[1]s_fc"
+  device_id = netbox_device.test.id
+  type      = "16gfc-sfpp"
+  wwn       = "aa:bb:cc:dd:ee:ff:00:11"
+}`, testName)
+}
+
+func testAccNetboxDeviceInterfaceWirelessCleared(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_wireless_lan" "test" {
+  ssid = "%[1]s"
+}
+
+resource "netbox_device_interface" "lag" {
+  name      = "%[1]s_lag"
+  device_id = netbox_device.test.id
+  type      = "lag"
+}
+
+resource "netbox_device_interface" "poe" {
+  name      = "%[1]s_poe"
+  device_id = netbox_device.test.id
+  type      = "1000base-t"
+}
+
+resource "netbox_device_interface" "radio" {
+  name      = "%[1]s_radio"
+  device_id = netbox_device.test.id
+  type      = "ieee802.11ac"
+}
+
+resource "netbox_device_interface" "fc" {
+  name      = "%[1]s_fc"
+  device_id = netbox_device.test.id
+  type      = "16gfc-sfpp"
+}`, testName)
+}
+
+func TestAccNetboxDeviceInterface_wirelessAndPoe(t *testing.T) {
+	testSlug := "iface_wireless"
+	testName := testAccGetTestName(testSlug)
+	setUp := testAccNetboxDeviceInterfaceFullDependencies(testName)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDeviceInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: setUp + testAccNetboxDeviceInterfaceWireless(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device_interface.poe", "lag_device_interface_id", "netbox_device_interface.lag", "id"),
+					resource.TestCheckResourceAttr("netbox_device_interface.poe", "speed", "1000000"),
+					resource.TestCheckResourceAttr("netbox_device_interface.poe", "duplex", "full"),
+					resource.TestCheckResourceAttr("netbox_device_interface.poe", "mark_connected", "true"),
+					resource.TestCheckResourceAttr("netbox_device_interface.poe", "poe_mode", "pse"),
+					resource.TestCheckResourceAttr("netbox_device_interface.poe", "poe_type", "type2-ieee802.3at"),
+					resource.TestCheckResourceAttr("netbox_device_interface.radio", "rf_role", "ap"),
+					resource.TestCheckResourceAttr("netbox_device_interface.radio", "rf_channel", "5g-36-5180-20"),
+					resource.TestCheckResourceAttr("netbox_device_interface.radio", "rf_channel_frequency", "5180"),
+					resource.TestCheckResourceAttr("netbox_device_interface.radio", "rf_channel_width", "20"),
+					resource.TestCheckResourceAttr("netbox_device_interface.radio", "tx_power", "20"),
+					resource.TestCheckResourceAttr("netbox_device_interface.radio", "wireless_lan_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_device_interface.radio", "wireless_lan_ids.*", "netbox_wireless_lan.test", "id"),
+					resource.TestCheckResourceAttr("netbox_device_interface.fc", "wwn", "AA:BB:CC:DD:EE:FF:00:11"),
+				),
+			},
+			{
+				ResourceName:      "netbox_device_interface.poe",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_device_interface.radio",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: setUp + strings.NewReplacer(`"5g-36-5180-20"`, `"2.4g-6-2437-22"`, "tx_power         = 20", "tx_power         = 0").Replace(testAccNetboxDeviceInterfaceWireless(testName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface.radio", "rf_channel", "2.4g-6-2437-22"),
+					resource.TestCheckResourceAttr("netbox_device_interface.radio", "rf_channel_frequency", "2437"),
+					resource.TestCheckResourceAttr("netbox_device_interface.radio", "rf_channel_width", "22"),
+					resource.TestCheckResourceAttr("netbox_device_interface.radio", "tx_power", "0"),
+				),
+			},
+			{
+				Config: setUp + testAccNetboxDeviceInterfaceWirelessCleared(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface.poe", "lag_device_interface_id", "0"),
+					resource.TestCheckResourceAttr("netbox_device_interface.poe", "duplex", ""),
+					resource.TestCheckResourceAttr("netbox_device_interface.poe", "mark_connected", "false"),
+					resource.TestCheckResourceAttr("netbox_device_interface.poe", "poe_mode", ""),
+					resource.TestCheckResourceAttr("netbox_device_interface.poe", "poe_type", ""),
+					resource.TestCheckResourceAttr("netbox_device_interface.radio", "rf_role", ""),
+					resource.TestCheckResourceAttr("netbox_device_interface.radio", "rf_channel", ""),
+					resource.TestCheckResourceAttr("netbox_device_interface.radio", "rf_channel_frequency", "0"),
+					resource.TestCheckResourceAttr("netbox_device_interface.radio", "rf_channel_width", "0"),
+					resource.TestCheckResourceAttr("netbox_device_interface.radio", "tx_power", "0"),
+					resource.TestCheckResourceAttr("netbox_device_interface.radio", "wireless_lan_ids.#", "0"),
+					resource.TestCheckResourceAttr("netbox_device_interface.fc", "wwn", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccNetboxDeviceInterfaceVrfDependencies(testName string) string {
 	return testAccNetboxDeviceInterfaceFullDependencies(testName) + fmt.Sprintf(`
 resource "netbox_vrf" "test" {
@@ -0,0 +1,153 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type dnsNameserver struct {
+	ID          int64               `json:"id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Tenant      *rawNestedObject    `json:"tenant"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+type writableDNSNameserver struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Tenant      *int64              `json:"tenant"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+func resourceNetboxDNSNameserver() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxDNSNameserverCreate,
+		ReadContext:   resourceNetboxDNSNameserverRead,
+		UpdateContext: resourceNetboxDNSNameserverUpdate,
+		DeleteContext: resourceNetboxDNSNameserverDelete,
+
+		Description: `:meta:subcategory:Plugins:Manages a name server of the [netbox-dns](https://github.com/peteeckel/netbox-plugin-dns) plugin. Name servers are assigned to zones as NS records and as primary name server in the SOA record.
+
+This resource requires the netbox-dns plugin.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+				Description:  "The fully qualified name of the name server, e.g. `ns1.example.com`.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getDNSNameserverFromResourceData(api *providerState, d *schema.ResourceData) (*writableDNSNameserver, error) {
+	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return &writableDNSNameserver{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Tenant:      getOptionalInt(d, "tenant_id"),
+		Tags:        tags,
+	}, nil
+}
+
+func resourceNetboxDNSNameserverCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getDNSNameserverFromResourceData(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var res dnsNameserver
+	if err := netboxRawRequest(api, "POST", dnsPluginPath+"/nameservers/", nil, data, &res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxDNSNameserverRead(ctx, d, m)
+}
+
+func resourceNetboxDNSNameserverRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var nameserver dnsNameserver
+	if err := netboxRawRequest(api, "GET", fmt.Sprintf("%s/nameservers/%s/", dnsPluginPath, d.Id()), nil, nil, &nameserver); err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", nameserver.Name)
+	d.Set("description", nameserver.Description)
+	if nameserver.Tenant != nil {
+		d.Set("tenant_id", nameserver.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	api.readTags(d, nameserver.Tags)
+
+	return nil
+}
+
+func resourceNetboxDNSNameserverUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getDNSNameserverFromResourceData(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := netboxRawRequest(api, "PUT", fmt.Sprintf("%s/nameservers/%s/", dnsPluginPath, d.Id()), nil, data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDNSNameserverRead(ctx, d, m)
+}
+
+func resourceNetboxDNSNameserverDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	err := netboxRawRequest(api, "DELETE", fmt.Sprintf("%s/nameservers/%s/", dnsPluginPath, d.Id()), nil, nil, nil)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxDNSRecordStatusOptions = []string{"active", "inactive"}
+
+type dnsRecord struct {
+	ID          int64               `json:"id"`
+	Zone        *rawNestedObject    `json:"zone"`
+	Name        string              `json:"name"`
+	Type        *rawChoice          `json:"type"`
+	Value       string              `json:"value"`
+	Status      *rawChoice          `json:"status"`
+	TTL         *int64              `json:"ttl"`
+	DisablePTR  bool                `json:"disable_ptr"`
+	Managed     bool                `json:"managed"`
+	PTRRecord   *rawNestedObject    `json:"ptr_record"`
+	Description string              `json:"description"`
+	Tenant      *rawNestedObject    `json:"tenant"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+type writableDNSRecord struct {
+	Zone        int64               `json:"zone"`
+	Name        string              `json:"name"`
+	Type        string              `json:"type"`
+	Value       string              `json:"value"`
+	Status      string              `json:"status"`
+	TTL         *int64              `json:"ttl"`
+	DisablePTR  bool                `json:"disable_ptr"`
+	Description string              `json:"description"`
+	Tenant      *int64              `json:"tenant"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+func resourceNetboxDNSRecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxDNSRecordCreate,
+		ReadContext:   resourceNetboxDNSRecordRead,
+		UpdateContext: resourceNetboxDNSRecordUpdate,
+		DeleteContext: resourceNetboxDNSRecordDelete,
+
+		Description: `:meta:subcategory:Plugins:Manages a record of a zone of the [netbox-dns](https://github.com/peteeckel/netbox-plugin-dns) plugin. For A and AAAA records, the plugin maintains the PTR record in the matching reverse zone, unless ` + "`disable_ptr`" + ` is set.
+
+To create records for IP addresses managed with ` + "`netbox_ip_address`" + `, use the address without prefix length as value, e.g. ` + "`split(\"/\", netbox_ip_address.test.ip_address)[0]`" + `.
+
+This resource requires the netbox-dns plugin.`,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+				Description:  "The name of the record relative to the zone, `@` for the zone itself.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 10),
+				Description:  "The record type, e.g. `A`, `AAAA`, `CNAME` or `TXT`.",
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice(resourceNetboxDNSRecordStatusOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDNSRecordStatusOptions),
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Without TTL, the default TTL of the zone applies.",
+			},
+			"disable_ptr": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Do not create a PTR record for A and AAAA records.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"managed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the record is maintained by the plugin.",
+			},
+			"ptr_record_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the PTR record the plugin maintains for this record.",
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getDNSRecordFromResourceData(api *providerState, d *schema.ResourceData) (*writableDNSRecord, error) {
+	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return &writableDNSRecord{
+		Zone:        int64(d.Get("zone_id").(int)),
+		Name:        d.Get("name").(string),
+		Type:        d.Get("type").(string),
+		Value:       d.Get("value").(string),
+		Status:      d.Get("status").(string),
+		TTL:         getOptionalInt(d, "ttl"),
+		DisablePTR:  d.Get("disable_ptr").(bool),
+		Description: d.Get("description").(string),
+		Tenant:      getOptionalInt(d, "tenant_id"),
+		Tags:        tags,
+	}, nil
+}
+
+func resourceNetboxDNSRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getDNSRecordFromResourceData(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var res dnsRecord
+	if err := netboxRawRequest(api, "POST", dnsPluginPath+"/records/", nil, data, &res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxDNSRecordRead(ctx, d, m)
+}
+
+func resourceNetboxDNSRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var record dnsRecord
+	if err := netboxRawRequest(api, "GET", fmt.Sprintf("%s/records/%s/", dnsPluginPath, d.Id()), nil, nil, &record); err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	setDNSRecordAttributes(d, &record)
+	api.readTags(d, record.Tags)
+
+	return nil
+}
+
+// setDNSRecordAttributes sets the attributes shared by the resource and the
+// data source.
+func setDNSRecordAttributes(d *schema.ResourceData, record *dnsRecord) {
+	if record.Zone != nil {
+		d.Set("zone_id", record.Zone.ID)
+	}
+	d.Set("name", record.Name)
+	if record.Type != nil {
+		d.Set("type", record.Type.Value)
+	}
+	d.Set("value", record.Value)
+	if record.Status != nil {
+		d.Set("status", record.Status.Value)
+	}
+	d.Set("ttl", record.TTL)
+	d.Set("disable_ptr", record.DisablePTR)
+	d.Set("managed", record.Managed)
+	if record.PTRRecord != nil {
+		d.Set("ptr_record_id", record.PTRRecord.ID)
+	} else {
+		d.Set("ptr_record_id", nil)
+	}
+	d.Set("description", record.Description)
+	if record.Tenant != nil {
+		d.Set("tenant_id", record.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+}
+
+func resourceNetboxDNSRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getDNSRecordFromResourceData(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := netboxRawRequest(api, "PUT", fmt.Sprintf("%s/records/%s/", dnsPluginPath, d.Id()), nil, data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDNSRecordRead(ctx, d, m)
+}
+
+func resourceNetboxDNSRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	err := netboxRawRequest(api, "DELETE", fmt.Sprintf("%s/records/%s/", dnsPluginPath, d.Id()), nil, nil, nil)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDNSRecordTestServer(t *testing.T, records []map[string]interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/plugins/netbox-dns/records/":
+			var received map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+				t.Errorf("failed to decode record request: %v", err)
+			}
+			assert.Equal(t, float64(9), received["zone"])
+			assert.Equal(t, "192.0.2.10", received["value"])
+			assert.Nil(t, received["ttl"])
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(records[0])
+		case r.Method == "GET" && r.URL.Path == "/api/plugins/netbox-dns/records/15/":
+			json.NewEncoder(w).Encode(records[0])
+		case r.Method == "GET" && r.URL.Path == "/api/plugins/netbox-dns/records/":
+			assert.Equal(t, "9", r.URL.Query().Get("zone_id"))
+			assert.Equal(t, "www", r.URL.Query().Get("name"))
+			json.NewEncoder(w).Encode(map[string]interface{}{"count": len(records), "results": records})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+var testDNSRecord = map[string]interface{}{
+	"id":          15,
+	"zone":        map[string]interface{}{"id": 9, "name": "example.com"},
+	"name":        "www",
+	"type":        "A",
+	"value":       "192.0.2.10",
+	"status":      map[string]interface{}{"value": "active", "label": "Active"},
+	"ttl":         nil,
+	"disable_ptr": false,
+	"managed":     false,
+	"ptr_record":  map[string]interface{}{"id": 16, "name": "10"},
+	"tags":        []interface{}{},
+}
+
+func TestResourceNetboxDNSRecordCreate(t *testing.T) {
+	ts := newDNSRecordTestServer(t, []map[string]interface{}{testDNSRecord})
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().ResourcesMap["netbox_dns_record"].Schema, map[string]interface{}{
+		"zone_id": 9,
+		"name":    "www",
+		"type":    "A",
+		"value":   "192.0.2.10",
+	})
+
+	diags := resourceNetboxDNSRecordCreate(context.Background(), d, testMockProviderState(t, ts.URL))
+	if diags.HasError() {
+		t.Fatalf("resourceNetboxDNSRecordCreate returned error: %v", diags)
+	}
+
+	assert.Equal(t, "15", d.Id())
+	assert.Equal(t, "A", d.Get("type"))
+	assert.Equal(t, "active", d.Get("status"))
+	assert.Equal(t, 0, d.Get("ttl"))
+	assert.Equal(t, 16, d.Get("ptr_record_id"))
+}
+
+func TestDataSourceNetboxDNSRecordRead(t *testing.T) {
+	ts := newDNSRecordTestServer(t, []map[string]interface{}{testDNSRecord})
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxDNSRecord().Schema, map[string]interface{}{
+		"zone_id": 9,
+		"name":    "www",
+	})
+
+	err := dataSourceNetboxDNSRecordRead(d, testMockProviderState(t, ts.URL))
+	assert.NoError(t, err)
+	assert.Equal(t, "15", d.Id())
+	assert.Equal(t, 15, d.Get("record_id"))
+	assert.Equal(t, "192.0.2.10", d.Get("value"))
+}
+
+func TestDataSourceNetboxDNSRecordRead_ambiguous(t *testing.T) {
+	second := map[string]interface{}{"id": 17, "name": "www", "type": "AAAA", "value": "2001:db8::10"}
+	ts := newDNSRecordTestServer(t, []map[string]interface{}{testDNSRecord, second})
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxDNSRecord().Schema, map[string]interface{}{
+		"zone_id": 9,
+		"name":    "www",
+	})
+
+	err := dataSourceNetboxDNSRecordRead(d, testMockProviderState(t, ts.URL))
+	assert.EqualError(t, err, "more than one DNS record returned, specify a more narrow filter")
+}
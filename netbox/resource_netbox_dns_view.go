@@ -0,0 +1,159 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type dnsView struct {
+	ID          int64               `json:"id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	DefaultView bool                `json:"default_view"`
+	Tenant      *rawNestedObject    `json:"tenant"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+type writableDNSView struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Tenant      *int64              `json:"tenant"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+func resourceNetboxDNSView() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxDNSViewCreate,
+		ReadContext:   resourceNetboxDNSViewRead,
+		UpdateContext: resourceNetboxDNSViewUpdate,
+		DeleteContext: resourceNetboxDNSViewDelete,
+
+		Description: `:meta:subcategory:Plugins:Manages a view of the [netbox-dns](https://github.com/peteeckel/netbox-plugin-dns) plugin. Views separate zones with the same name, e.g. for internal and external DNS.
+
+This resource requires the netbox-dns plugin.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default_view": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether zones without view are assigned to this view.",
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getDNSViewFromResourceData(api *providerState, d *schema.ResourceData) (*writableDNSView, error) {
+	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return &writableDNSView{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Tenant:      getOptionalInt(d, "tenant_id"),
+		Tags:        tags,
+	}, nil
+}
+
+func resourceNetboxDNSViewCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getDNSViewFromResourceData(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var res dnsView
+	if err := netboxRawRequest(api, "POST", dnsPluginPath+"/views/", nil, data, &res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxDNSViewRead(ctx, d, m)
+}
+
+func resourceNetboxDNSViewRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var view dnsView
+	if err := netboxRawRequest(api, "GET", fmt.Sprintf("%s/views/%s/", dnsPluginPath, d.Id()), nil, nil, &view); err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", view.Name)
+	d.Set("description", view.Description)
+	d.Set("default_view", view.DefaultView)
+	if view.Tenant != nil {
+		d.Set("tenant_id", view.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	api.readTags(d, view.Tags)
+
+	return nil
+}
+
+func resourceNetboxDNSViewUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getDNSViewFromResourceData(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := netboxRawRequest(api, "PUT", fmt.Sprintf("%s/views/%s/", dnsPluginPath, d.Id()), nil, data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDNSViewRead(ctx, d, m)
+}
+
+func resourceNetboxDNSViewDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	err := netboxRawRequest(api, "DELETE", fmt.Sprintf("%s/views/%s/", dnsPluginPath, d.Id()), nil, nil, nil)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,322 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dnsPluginPath is the API path of the netbox-dns plugin, relative to the
+// API base path.
+const dnsPluginPath = "/plugins/netbox-dns"
+
+var resourceNetboxDNSZoneStatusOptions = []string{"active", "reserved", "deprecated", "parked", "dynamic"}
+
+type dnsZone struct {
+	ID            int64               `json:"id"`
+	Name          string              `json:"name"`
+	View          *rawNestedObject    `json:"view"`
+	Nameservers   []rawNestedObject   `json:"nameservers"`
+	Status        *rawChoice          `json:"status"`
+	Description   string              `json:"description"`
+	DefaultTTL    int64               `json:"default_ttl"`
+	SOATTL        int64               `json:"soa_ttl"`
+	SOAMName      *rawNestedObject    `json:"soa_mname"`
+	SOARName      string              `json:"soa_rname"`
+	SOASerial     int64               `json:"soa_serial"`
+	SOASerialAuto bool                `json:"soa_serial_auto"`
+	SOARefresh    int64               `json:"soa_refresh"`
+	SOARetry      int64               `json:"soa_retry"`
+	SOAExpire     int64               `json:"soa_expire"`
+	SOAMinimum    int64               `json:"soa_minimum"`
+	Tenant        *rawNestedObject    `json:"tenant"`
+	Tags          []*models.NestedTag `json:"tags"`
+}
+
+// writableDNSZone leaves out the SOA values that are not configured, so that
+// the defaults of the plugin apply.
+type writableDNSZone struct {
+	Name          string              `json:"name"`
+	View          *int64              `json:"view,omitempty"`
+	Nameservers   []int64             `json:"nameservers"`
+	Status        string              `json:"status"`
+	Description   string              `json:"description"`
+	DefaultTTL    *int64              `json:"default_ttl,omitempty"`
+	SOATTL        *int64              `json:"soa_ttl,omitempty"`
+	SOAMName      *int64              `json:"soa_mname,omitempty"`
+	SOARName      string              `json:"soa_rname,omitempty"`
+	SOASerial     *int64              `json:"soa_serial,omitempty"`
+	SOASerialAuto bool                `json:"soa_serial_auto"`
+	SOARefresh    *int64              `json:"soa_refresh,omitempty"`
+	SOARetry      *int64              `json:"soa_retry,omitempty"`
+	SOAExpire     *int64              `json:"soa_expire,omitempty"`
+	SOAMinimum    *int64              `json:"soa_minimum,omitempty"`
+	Tenant        *int64              `json:"tenant"`
+	Tags          []*models.NestedTag `json:"tags"`
+}
+
+func resourceNetboxDNSZone() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxDNSZoneCreate,
+		ReadContext:   resourceNetboxDNSZoneRead,
+		UpdateContext: resourceNetboxDNSZoneUpdate,
+		DeleteContext: resourceNetboxDNSZoneDelete,
+
+		Description: `:meta:subcategory:Plugins:Manages a zone of the [netbox-dns](https://github.com/peteeckel/netbox-plugin-dns) plugin. The plugin maintains the SOA and NS records of the zone.
+
+SOA values that are not configured are set by the plugin from its defaults.
+
+This resource requires the netbox-dns plugin.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+				Description:  "The name of the zone, e.g. `example.com`.",
+			},
+			"view_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Defaults to the default view of the plugin.",
+			},
+			"nameservers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the name servers of the zone.",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice(resourceNetboxDNSZoneStatusOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDNSZoneStatusOptions),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The TTL of records without TTL.",
+			},
+			"soa_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"soa_mname_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the primary name server of the zone.",
+			},
+			"soa_rname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The mailbox of the responsible person, e.g. `hostmaster.example.com`.",
+			},
+			"soa_serial_auto": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the plugin increments the serial on every change of the zone.",
+			},
+			"soa_serial": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Only used if `soa_serial_auto` is false.",
+			},
+			"soa_refresh": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"soa_retry": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"soa_expire": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"soa_minimum": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getDNSZoneFromResourceData(api *providerState, d *schema.ResourceData) (*writableDNSZone, error) {
+	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	data := &writableDNSZone{
+		Name:          d.Get("name").(string),
+		View:          getOptionalInt(d, "view_id"),
+		Nameservers:   toInt64List(d.Get("nameservers")),
+		Status:        d.Get("status").(string),
+		Description:   d.Get("description").(string),
+		DefaultTTL:    getOptionalInt(d, "default_ttl"),
+		SOATTL:        getOptionalInt(d, "soa_ttl"),
+		SOAMName:      getOptionalInt(d, "soa_mname_id"),
+		SOARName:      d.Get("soa_rname").(string),
+		SOASerialAuto: d.Get("soa_serial_auto").(bool),
+		SOARefresh:    getOptionalInt(d, "soa_refresh"),
+		SOARetry:      getOptionalInt(d, "soa_retry"),
+		SOAExpire:     getOptionalInt(d, "soa_expire"),
+		SOAMinimum:    getOptionalInt(d, "soa_minimum"),
+		Tenant:        getOptionalInt(d, "tenant_id"),
+		Tags:          tags,
+	}
+
+	// The plugin maintains the serial itself, the one in the state is outdated
+	// after the next change of a record
+	if !data.SOASerialAuto {
+		data.SOASerial = getOptionalInt(d, "soa_serial")
+	}
+
+	return data, nil
+}
+
+func resourceNetboxDNSZoneCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getDNSZoneFromResourceData(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var res dnsZone
+	if err := netboxRawRequest(api, "POST", dnsPluginPath+"/zones/", nil, data, &res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxDNSZoneRead(ctx, d, m)
+}
+
+func resourceNetboxDNSZoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var zone dnsZone
+	if err := netboxRawRequest(api, "GET", fmt.Sprintf("%s/zones/%s/", dnsPluginPath, d.Id()), nil, nil, &zone); err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", zone.Name)
+	if zone.View != nil {
+		d.Set("view_id", zone.View.ID)
+	} else {
+		d.Set("view_id", nil)
+	}
+	d.Set("nameservers", getIDsFromRawNestedObjects(zone.Nameservers))
+	if zone.Status != nil {
+		d.Set("status", zone.Status.Value)
+	}
+	d.Set("description", zone.Description)
+	d.Set("default_ttl", zone.DefaultTTL)
+	d.Set("soa_ttl", zone.SOATTL)
+	if zone.SOAMName != nil {
+		d.Set("soa_mname_id", zone.SOAMName.ID)
+	} else {
+		d.Set("soa_mname_id", nil)
+	}
+	d.Set("soa_rname", zone.SOARName)
+	d.Set("soa_serial", zone.SOASerial)
+	d.Set("soa_serial_auto", zone.SOASerialAuto)
+	d.Set("soa_refresh", zone.SOARefresh)
+	d.Set("soa_retry", zone.SOARetry)
+	d.Set("soa_expire", zone.SOAExpire)
+	d.Set("soa_minimum", zone.SOAMinimum)
+	if zone.Tenant != nil {
+		d.Set("tenant_id", zone.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	api.readTags(d, zone.Tags)
+
+	return nil
+}
+
+func resourceNetboxDNSZoneUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getDNSZoneFromResourceData(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := netboxRawRequest(api, "PUT", fmt.Sprintf("%s/zones/%s/", dnsPluginPath, d.Id()), nil, data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDNSZoneRead(ctx, d, m)
+}
+
+func resourceNetboxDNSZoneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	err := netboxRawRequest(api, "DELETE", fmt.Sprintf("%s/zones/%s/", dnsPluginPath, d.Id()), nil, nil, nil)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// getIDsFromRawNestedObjects returns the IDs of the nested objects of a raw
+// API response.
+func getIDsFromRawNestedObjects(objects []rawNestedObject) []int64 {
+	ids := make([]int64, 0, len(objects))
+	for _, object := range objects {
+		ids = append(ids, object.ID)
+	}
+	return ids
+}
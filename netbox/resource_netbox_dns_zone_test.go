@@ -0,0 +1,90 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceNetboxDNSZoneCreate(t *testing.T) {
+	var received map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		zone := map[string]interface{}{
+			"id":              9,
+			"name":            "example.com",
+			"view":            map[string]interface{}{"id": 1, "name": "_default_"},
+			"nameservers":     []interface{}{map[string]interface{}{"id": 3, "name": "ns1.example.com"}},
+			"status":          "active",
+			"default_ttl":     86400,
+			"soa_ttl":         86400,
+			"soa_mname":       map[string]interface{}{"id": 3, "name": "ns1.example.com"},
+			"soa_rname":       "hostmaster.example.com",
+			"soa_serial":      1718000000,
+			"soa_serial_auto": true,
+			"soa_refresh":     43200,
+			"soa_retry":       7200,
+			"soa_expire":      2419200,
+			"soa_minimum":     3600,
+			"tenant":          nil,
+			"tags":            []interface{}{},
+		}
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/plugins/netbox-dns/zones/":
+			if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+				t.Errorf("failed to decode zone request: %v", err)
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(zone)
+		case r.Method == "GET" && r.URL.Path == "/api/plugins/netbox-dns/zones/9/":
+			json.NewEncoder(w).Encode(zone)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().ResourcesMap["netbox_dns_zone"].Schema, map[string]interface{}{
+		"name":         "example.com",
+		"nameservers":  []interface{}{3},
+		"soa_mname_id": 3,
+		"soa_serial":   1,
+	})
+
+	diags := resourceNetboxDNSZoneCreate(context.Background(), d, testMockProviderState(t, ts.URL))
+	if diags.HasError() {
+		t.Fatalf("resourceNetboxDNSZoneCreate returned error: %v", diags)
+	}
+
+	// the plugin defaults apply to SOA values that are not configured, and the
+	// serial is maintained by the plugin
+	assert.Equal(t, float64(3), received["soa_mname"])
+	assert.Equal(t, []interface{}{float64(3)}, received["nameservers"])
+	assert.NotContains(t, received, "soa_refresh")
+	assert.NotContains(t, received, "soa_serial")
+	assert.NotContains(t, received, "view")
+
+	assert.Equal(t, "9", d.Id())
+	assert.Equal(t, 1, d.Get("view_id"))
+	assert.Equal(t, "active", d.Get("status"))
+	assert.Equal(t, 43200, d.Get("soa_refresh"))
+	assert.Equal(t, 1718000000, d.Get("soa_serial"))
+	assert.Equal(t, []interface{}{3}, d.Get("nameservers").(*schema.Set).List())
+}
+
+func TestResourceNetboxDNSZoneRead_notFound(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().ResourcesMap["netbox_dns_zone"].Schema, map[string]interface{}{"name": "example.com"})
+	d.SetId("9")
+
+	diags := resourceNetboxDNSZoneRead(context.Background(), d, testMockProviderState(t, ts.URL))
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", d.Id())
+}
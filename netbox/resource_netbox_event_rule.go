@@ -2,6 +2,8 @@ package netbox
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
@@ -10,11 +12,37 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var resourceNetboxEventRuleActionTypeOptions = []string{"webhook", "script"}
+var resourceNetboxEventRuleActionTypeOptions = []string{"webhook", "script", "notification"}
 
 var resourceNetboxEventRuleActionTypeToObjectType = map[string]string{
-	"webhook": "extras.webhook",
-	"script":  "extras.script",
+	"webhook":      "extras.webhook",
+	"script":       "extras.script",
+	"notification": "extras.notificationgroup",
+}
+
+// resourceNetboxEventRuleActionObjectResolvers resolve the `action_object_name`
+// of an event rule to an ID, keyed by the action object type.
+var resourceNetboxEventRuleActionObjectResolvers = map[string]func(api *providerState, name string) (int64, error){
+	"extras.webhook":           getWebhookIDByName,
+	"extras.notificationgroup": getNotificationGroupIDByName,
+	"extras.script": func(api *providerState, name string) (int64, error) {
+		script, err := getScriptByQualifiedName(api, name)
+		if err != nil {
+			return 0, err
+		}
+		return script.ID, nil
+	},
+}
+
+// objectNameNotFoundError is returned by the action object resolvers if no
+// object with the given name exists.
+type objectNameNotFoundError struct {
+	objectName string
+	name       string
+}
+
+func (e *objectNameNotFoundError) Error() string {
+	return fmt.Sprintf("no %s found with name %q", e.objectName, e.name)
 }
 
 func resourceNetboxEventRule() *schema.Resource {
@@ -27,7 +55,8 @@ func resourceNetboxEventRule() *schema.Resource {
 		Description: `:meta:subcategory:Extras:From the [official documentation](https://docs.netbox.dev/en/stable/features/event-rules/):
 
 > NetBox can be configured via Event Rules to transmit outgoing webhooks to remote systems in response to internal object changes. The receiver can act on the data in these webhook messages to perform related tasks.
-	Event rules can also execute custom scripts, enabling NetBox to run defined logic locally in response to object changes.`,
+	Event rules can also execute custom scripts, enabling NetBox to run defined logic locally in response to object changes.
+	With the ` + "`notification`" + ` action type, event rules notify the members of a notification group in NetBox (NetBox 4.1 and later).`,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -73,8 +102,16 @@ func resourceNetboxEventRule() *schema.Resource {
 				Description:  buildValidValueDescription(resourceNetboxEventRuleActionTypeOptions),
 			},
 			"action_object_id": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"action_object_id", "action_object_name"},
+			},
+			"action_object_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"action_object_id", "action_object_name"},
+				Description:  "The name of the action object, resolved to its ID when applying. For scripts, use `<module>.<class name>`, e.g. `build_rack.BuildRack`, so the rule keeps working when the script module is uploaded again. For webhooks and notification groups, use their name.",
 			},
 			tagsKey: tagsSchema,
 		},
@@ -107,7 +144,11 @@ func resourceNetboxEventRuleCreate(d *schema.ResourceData, m interface{}) error
 
 	enabled := d.Get("enabled").(bool)
 	data.Enabled = enabled
-	data.ActionObjectID = getOptionalInt(d, "action_object_id")
+	actionObjectID, err := getEventRuleActionObjectID(api, d, actionType)
+	if err != nil {
+		return err
+	}
+	data.ActionObjectID = actionObjectID
 
 	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
 	data.Tags = tags
@@ -167,6 +208,22 @@ func resourceNetboxEventRuleRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("enabled", eventRule.Enabled)
 	d.Set("action_object_id", eventRule.ActionObjectID)
 
+	// If the named action object was replaced (e.g. a script module was uploaded
+	// again), clear the name so the next plan updates the rule to the new ID
+	if actionObjectName, ok := d.GetOk("action_object_name"); ok {
+		actionObjectType := resourceNetboxEventRuleActionTypeToObjectType[eventRule.ActionType.Value]
+		if resolve, ok := resourceNetboxEventRuleActionObjectResolvers[actionObjectType]; ok {
+			actionObjectID, err := resolve(api, actionObjectName.(string))
+			var notFoundErr *objectNameNotFoundError
+			if err != nil && !errors.As(err, &notFoundErr) {
+				return err
+			}
+			if err != nil || actionObjectID != eventRule.ActionObjectID {
+				d.Set("action_object_name", "")
+			}
+		}
+	}
+
 	if eventRule.Conditions != nil {
 		conditions, err := json.Marshal(eventRule.Conditions)
 		if err != nil {
@@ -204,7 +261,11 @@ func resourceNetboxEventRuleUpdate(d *schema.ResourceData, m interface{}) error
 
 	enabled := d.Get("enabled").(bool)
 	data.Enabled = enabled
-	data.ActionObjectID = getOptionalInt(d, "action_object_id")
+	actionObjectID, err := getEventRuleActionObjectID(api, d, actionType)
+	if err != nil {
+		return err
+	}
+	data.ActionObjectID = actionObjectID
 
 	if conditionsData, ok := d.GetOk("conditions"); ok {
 		var conditions any
@@ -227,7 +288,7 @@ func resourceNetboxEventRuleUpdate(d *schema.ResourceData, m interface{}) error
 
 	params := extras.NewExtrasEventRulesUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Extras.ExtrasEventRulesUpdate(params, nil)
+	_, err = api.Extras.ExtrasEventRulesUpdate(params, nil)
 	if err != nil {
 		return err
 	}
@@ -235,6 +296,46 @@ func resourceNetboxEventRuleUpdate(d *schema.ResourceData, m interface{}) error
 	return resourceNetboxEventRuleRead(d, m)
 }
 
+// getEventRuleActionObjectID returns the configured action object ID, or
+// resolves it from the configured action object name.
+func getEventRuleActionObjectID(api *providerState, d *schema.ResourceData, actionType string) (*int64, error) {
+	actionObjectName, ok := d.GetOk("action_object_name")
+	if !ok {
+		return getOptionalInt(d, "action_object_id"), nil
+	}
+
+	actionObjectType := resourceNetboxEventRuleActionTypeToObjectType[actionType]
+	resolve, ok := resourceNetboxEventRuleActionObjectResolvers[actionObjectType]
+	if !ok {
+		return nil, fmt.Errorf("action_object_name is not supported for action type %q", actionType)
+	}
+
+	actionObjectID, err := resolve(api, actionObjectName.(string))
+	if err != nil {
+		return nil, err
+	}
+	return &actionObjectID, nil
+}
+
+func getWebhookIDByName(api *providerState, name string) (int64, error) {
+	params := extras.NewExtrasWebhooksListParams()
+	params.Name = &name
+	params.Limit = int64ToPtr(2)
+
+	res, err := api.Extras.ExtrasWebhooksList(params, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if *res.GetPayload().Count > int64(1) {
+		return 0, errors.New("more than one webhook returned, specify a more narrow filter")
+	}
+	if *res.GetPayload().Count == int64(0) {
+		return 0, &objectNameNotFoundError{objectName: "webhook", name: name}
+	}
+	return res.GetPayload().Results[0].ID, nil
+}
+
 func resourceNetboxEventRuleDelete(d *schema.ResourceData, m interface{}) error {
 	api := m.(*providerState)
 
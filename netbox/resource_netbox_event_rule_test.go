@@ -1,17 +1,108 @@
 package netbox
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
+// newEventRuleTestServer returns a mock NetBox with a notification event rule
+// 1 pointing to notification group 5. Notification group lookups are answered
+// by the given handler.
+func newEventRuleTestServer(groups http.HandlerFunc) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/extras/event-rules/1/":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":               1,
+				"name":             "test",
+				"object_types":     []string{"dcim.site"},
+				"event_types":      []string{"object_created"},
+				"action_type":      map[string]string{"value": "notification", "label": "Notification"},
+				"action_object_id": 5,
+				"enabled":          true,
+			})
+		case "/api/extras/notification-groups/":
+			groups(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func testEventRuleResourceData(t *testing.T) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, Provider().ResourcesMap["netbox_event_rule"].Schema, map[string]interface{}{
+		"action_object_name": "operators",
+	})
+	d.SetId("1")
+	return d
+}
+
+func TestResourceNetboxEventRuleRead_actionObjectName(t *testing.T) {
+	ts := newEventRuleTestServer(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"count": 1, "results": []map[string]interface{}{{"id": 5, "name": "operators"}}})
+	})
+	defer ts.Close()
+
+	d := testEventRuleResourceData(t)
+	err := resourceNetboxEventRuleRead(d, testMockProviderState(t, ts.URL))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "operators", d.Get("action_object_name"))
+}
+
+func TestResourceNetboxEventRuleRead_actionObjectReplaced(t *testing.T) {
+	ts := newEventRuleTestServer(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"count": 1, "results": []map[string]interface{}{{"id": 6, "name": "operators"}}})
+	})
+	defer ts.Close()
+
+	d := testEventRuleResourceData(t)
+	err := resourceNetboxEventRuleRead(d, testMockProviderState(t, ts.URL))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "", d.Get("action_object_name"))
+}
+
+func TestResourceNetboxEventRuleRead_actionObjectDeleted(t *testing.T) {
+	ts := newEventRuleTestServer(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"count": 0, "results": []map[string]interface{}{}})
+	})
+	defer ts.Close()
+
+	d := testEventRuleResourceData(t)
+	err := resourceNetboxEventRuleRead(d, testMockProviderState(t, ts.URL))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "", d.Get("action_object_name"))
+}
+
+func TestResourceNetboxEventRuleRead_actionObjectLookupFailed(t *testing.T) {
+	ts := newEventRuleTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"detail": "server error"}`))
+	})
+	defer ts.Close()
+
+	d := testEventRuleResourceData(t)
+	err := resourceNetboxEventRuleRead(d, testMockProviderState(t, ts.URL))
+
+	assert.Error(t, err)
+	assert.Equal(t, "operators", d.Get("action_object_name"))
+}
+
 func TestAccNetboxEventRule_basic(t *testing.T) {
 	testName := testAccGetTestName("evt_rule_basic")
 	resource.ParallelTest(t, resource.TestCase{
@@ -74,6 +165,122 @@ resource "netbox_event_rule" "test" {
 	})
 }
 
+func TestAccNetboxEventRule_actionObjectName(t *testing.T) {
+	testName := testAccGetTestName("evt_rule_obj_name")
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetBoxEventRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_webhook" "test" {
+  name        = "%[1]s"
+  payload_url = "https://example.com/webhook"
+}
+
+resource "netbox_event_rule" "test" {
+  name               = "%[1]s"
+  content_types      = ["dcim.site"]
+  action_type        = "webhook"
+  action_object_name = netbox_webhook.test.name
+  event_types        = ["object_created"]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_event_rule.test", "action_object_name", testName),
+					resource.TestCheckResourceAttrPair("netbox_event_rule.test", "action_object_id", "netbox_webhook.test", "id"),
+				),
+			},
+			{
+				// Replacing the webhook must move the rule to the new webhook
+				Config: fmt.Sprintf(`
+resource "netbox_webhook" "test" {
+  name        = "%[1]s"
+  payload_url = "https://example.com/webhook"
+}
+
+resource "netbox_webhook" "replacement" {
+  name        = "%[1]s-new"
+  payload_url = "https://example.com/webhook"
+}
+
+resource "netbox_event_rule" "test" {
+  name               = "%[1]s"
+  content_types      = ["dcim.site"]
+  action_type        = "webhook"
+  action_object_name = netbox_webhook.replacement.name
+  event_types        = ["object_created"]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_event_rule.test", "action_object_name", testName+"-new"),
+					resource.TestCheckResourceAttrPair("netbox_event_rule.test", "action_object_id", "netbox_webhook.replacement", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxEventRule_notification(t *testing.T) {
+	testName := testAccGetTestName("evt_rule_notification")
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetBoxEventRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_group" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_notification_group" "test" {
+  name      = "%[1]s"
+  group_ids = [netbox_group.test.id]
+}
+
+resource "netbox_event_rule" "test" {
+  name               = "%[1]s"
+  content_types      = ["dcim.device"]
+  action_type        = "notification"
+  action_object_name = netbox_notification_group.test.name
+  event_types        = ["object_updated"]
+  conditions         = jsonencode({ attr = "role.slug", value = "core-router" })
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_event_rule.test", "action_type", "notification"),
+					resource.TestCheckResourceAttrPair("netbox_event_rule.test", "action_object_id", "netbox_notification_group.test", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_group" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_notification_group" "test" {
+  name      = "%[1]s"
+  group_ids = [netbox_group.test.id]
+}
+
+resource "netbox_event_rule" "test" {
+  name             = "%[1]s"
+  content_types    = ["dcim.device"]
+  action_type      = "notification"
+  action_object_id = netbox_notification_group.test.id
+  event_types      = ["object_updated"]
+  conditions       = jsonencode({ attr = "role.slug", value = "core-router" })
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_event_rule.test", "action_object_id", "netbox_notification_group.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_event_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckNetBoxEventRuleDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*providerState)
 
@@ -0,0 +1,175 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxExportTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxExportTemplateCreate,
+		Read:   resourceNetboxExportTemplateRead,
+		Update: resourceNetboxExportTemplateUpdate,
+		Delete: resourceNetboxExportTemplateDelete,
+
+		Description: `:meta:subcategory:Extras:From the [official documentation](https://netboxlabs.com/docs/netbox/models/extras/exporttemplate/):
+
+> Export templates are used to render arbitrary data from a set of NetBox objects. For example, you might want to automatically generate a network monitoring service configuration from a list of device objects. Export templates are written in Jinja2.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"object_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The object types this template applies to, e.g. `dcim.device`.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"template_code": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Jinja2 template code. The list of objects being exported is passed as a context variable named `queryset`.",
+			},
+			"mime_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Defaults to `text/plain; charset=utf-8`.",
+			},
+			"file_extension": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Extension to append to the rendered filename.",
+			},
+			"as_attachment": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Download the rendered file as an attachment.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getExportTemplateFromResourceData(d *schema.ResourceData) *models.ExportTemplate {
+	return &models.ExportTemplate{
+		Name:          strToPtr(d.Get("name").(string)),
+		Description:   d.Get("description").(string),
+		TemplateCode:  strToPtr(d.Get("template_code").(string)),
+		MimeType:      d.Get("mime_type").(string),
+		FileExtension: d.Get("file_extension").(string),
+		AsAttachment:  d.Get("as_attachment").(bool),
+	}
+}
+
+// getExportTemplateOverrideFields returns the fields that are sent explicitly.
+// The go-netbox model only knows the pre-4.0 `content_types` and omits empty
+// values, which would prevent unsetting them.
+func getExportTemplateOverrideFields(d *schema.ResourceData) map[string]any {
+	return map[string]any{
+		"object_types":   toStringList(d.Get("object_types")),
+		"description":    d.Get("description").(string),
+		"mime_type":      d.Get("mime_type").(string),
+		"file_extension": d.Get("file_extension").(string),
+		"as_attachment":  d.Get("as_attachment").(bool),
+	}
+}
+
+func resourceNetboxExportTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := getExportTemplateFromResourceData(d)
+
+	params := extras.NewExtrasExportTemplatesCreateParams().WithData(data)
+
+	res, err := api.Extras.ExtrasExportTemplatesCreate(params, nil, hackSerializeWithValues(getExportTemplateOverrideFields(d)))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxExportTemplateRead(d, m)
+}
+
+func resourceNetboxExportTemplateRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := extras.NewExtrasExportTemplatesReadParams().WithID(id)
+
+	var objectTypes rawObjectTypes
+	res, err := api.Extras.ExtrasExportTemplatesRead(params, nil, withRawResponse(&objectTypes))
+	if err != nil {
+		if errresp, ok := err.(*extras.ExtrasExportTemplatesReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	tmpl := res.GetPayload()
+	d.Set("name", tmpl.Name)
+	d.Set("object_types", objectTypes.ObjectTypes)
+	d.Set("description", tmpl.Description)
+	d.Set("template_code", tmpl.TemplateCode)
+	d.Set("mime_type", tmpl.MimeType)
+	d.Set("file_extension", tmpl.FileExtension)
+	d.Set("as_attachment", tmpl.AsAttachment)
+
+	return nil
+}
+
+func resourceNetboxExportTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := getExportTemplateFromResourceData(d)
+
+	params := extras.NewExtrasExportTemplatesUpdateParams().WithID(id).WithData(data)
+
+	_, err := api.Extras.ExtrasExportTemplatesUpdate(params, nil, hackSerializeWithValues(getExportTemplateOverrideFields(d)))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxExportTemplateRead(d, m)
+}
+
+func resourceNetboxExportTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := extras.NewExtrasExportTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Extras.ExtrasExportTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*extras.ExtrasExportTemplatesDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
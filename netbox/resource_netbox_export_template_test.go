@@ -0,0 +1,60 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxExportTemplate_basic(t *testing.T) {
+	testName := testAccGetTestName("export_template")
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_export_template" "test" {
+  name           = "%[1]s"
+  object_types   = ["dcim.device"]
+  description    = "%[1]s description"
+  template_code  = "{%% for device in queryset %%}{{ device.name }}\n{%% endfor %%}"
+  mime_type      = "text/csv"
+  file_extension = "csv"
+  as_attachment  = false
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_export_template.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_export_template.test", "object_types.#", "1"),
+					resource.TestCheckResourceAttr("netbox_export_template.test", "object_types.0", "dcim.device"),
+					resource.TestCheckResourceAttr("netbox_export_template.test", "description", testName+" description"),
+					resource.TestCheckResourceAttr("netbox_export_template.test", "template_code", "{% for device in queryset %}{{ device.name }}\n{% endfor %}"),
+					resource.TestCheckResourceAttr("netbox_export_template.test", "mime_type", "text/csv"),
+					resource.TestCheckResourceAttr("netbox_export_template.test", "file_extension", "csv"),
+					resource.TestCheckResourceAttr("netbox_export_template.test", "as_attachment", "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_export_template" "test" {
+  name          = "%[1]s"
+  object_types  = ["dcim.device", "dcim.site"]
+  template_code = "{{ queryset | length }}"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_export_template.test", "object_types.#", "2"),
+					resource.TestCheckResourceAttr("netbox_export_template.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_export_template.test", "mime_type", ""),
+					resource.TestCheckResourceAttr("netbox_export_template.test", "file_extension", ""),
+					resource.TestCheckResourceAttr("netbox_export_template.test", "as_attachment", "true"),
+				),
+			},
+			{
+				ResourceName:      "netbox_export_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -14,7 +14,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var resourceNetboxInterfaceModeOptions = []string{"access", "tagged", "tagged-all"}
+var resourceNetboxInterfaceModeOptions = []string{"access", "tagged", "tagged-all", "q-in-q"}
+
+// interfaceVlanFields holds the VLAN attributes that the go-netbox interface models do not know about
+type interfaceVlanFields struct {
+	QinqSvlan             *rawNestedObject `json:"qinq_svlan"`
+	VlanTranslationPolicy *rawNestedObject `json:"vlan_translation_policy"`
+}
 
 func resourceNetboxInterface() *schema.Resource {
 	return &schema.Resource{
@@ -81,6 +87,15 @@ func resourceNetboxInterface() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"qinq_svlan_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the service VLAN (SVLAN) of this interface. Only valid when `mode` is `q-in-q`.",
+			},
+			"vlan_translation_policy_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
 			"bridge_interface_id": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -129,7 +144,7 @@ func resourceNetboxInterfaceCreate(ctx context.Context, d *schema.ResourceData,
 	}
 	params := virtualization.NewVirtualizationInterfacesCreateParams().WithData(&data)
 
-	res, err := api.Virtualization.VirtualizationInterfacesCreate(params, nil)
+	res, err := api.Virtualization.VirtualizationInterfacesCreate(params, nil, hackSerializeWithValues(getInterfaceVlanFields(d, false)))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -147,7 +162,8 @@ func resourceNetboxInterfaceRead(ctx context.Context, d *schema.ResourceData, m
 
 	params := virtualization.NewVirtualizationInterfacesReadParams().WithID(id)
 
-	res, err := api.Virtualization.VirtualizationInterfacesRead(params, nil)
+	var vlanFields interfaceVlanFields
+	res, err := api.Virtualization.VirtualizationInterfacesRead(params, nil, withRawResponse(&vlanFields))
 	if err != nil {
 		if errresp, ok := err.(*virtualization.VirtualizationInterfacesReadDefault); ok {
 			errorcode := errresp.Code()
@@ -185,6 +201,7 @@ func resourceNetboxInterfaceRead(ctx context.Context, d *schema.ResourceData, m
 	if iface.PrimaryMacAddress != nil {
 		d.Set("primary_mac_address_id", iface.PrimaryMacAddress.ID)
 	}
+	vlanFields.readInto(d)
 
 	return diags
 }
@@ -273,7 +290,7 @@ func resourceNetboxInterfaceUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	params := virtualization.NewVirtualizationInterfacesPartialUpdateParams().WithID(id).WithData(&data)
-	_, err = api.Virtualization.VirtualizationInterfacesPartialUpdate(params, nil, hackSerializeAsNull(nullFields...))
+	_, err = api.Virtualization.VirtualizationInterfacesPartialUpdate(params, nil, hackSerializeAsNull(nullFields...), hackSerializeWithValues(getInterfaceVlanFields(d, true)))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -358,3 +375,36 @@ func hackSerializeAsNull(fields ...string) virtualization.ClientOption {
 		co.Params = interceptParams{inner: originalParams, fields: fields}
 	}
 }
+
+// getInterfaceVlanFields returns the VLAN attributes missing from the go-netbox
+// interface models for the request body. Unset attributes are sent as `null`.
+// If onlyChanged is true, unchanged attributes are left out for partial updates.
+func getInterfaceVlanFields(d *schema.ResourceData, onlyChanged bool) map[string]any {
+	fields := make(map[string]any)
+	for key, field := range map[string]string{
+		"qinq_svlan_id":              "qinq_svlan",
+		"vlan_translation_policy_id": "vlan_translation_policy",
+	} {
+		if onlyChanged && !d.HasChange(key) {
+			continue
+		}
+		fields[field] = nil
+		if id, ok := d.GetOk(key); ok {
+			fields[field] = id.(int)
+		}
+	}
+	return fields
+}
+
+func (f interfaceVlanFields) readInto(d *schema.ResourceData) {
+	if f.QinqSvlan != nil {
+		d.Set("qinq_svlan_id", f.QinqSvlan.ID)
+	} else {
+		d.Set("qinq_svlan_id", nil)
+	}
+	if f.VlanTranslationPolicy != nil {
+		d.Set("vlan_translation_policy_id", f.VlanTranslationPolicy.ID)
+	} else {
+		d.Set("vlan_translation_policy_id", nil)
+	}
+}
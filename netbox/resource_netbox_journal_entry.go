@@ -0,0 +1,201 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxJournalEntryKindOptions = []string{"info", "success", "warning", "danger"}
+
+func resourceNetboxJournalEntry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxJournalEntryCreate,
+		Read:   resourceNetboxJournalEntryRead,
+		Update: resourceNetboxJournalEntryUpdate,
+		Delete: resourceNetboxJournalEntryDelete,
+
+		Description: `:meta:subcategory:Extras:From the [official documentation](https://netboxlabs.com/docs/netbox/features/journaling/):
+
+> All primary and organizational models in NetBox support journaling. A journal is a collection of human-generated notes and comments about an object maintained for historical context. It supplements NetBox's change log to provide additional information about why changes have been made or to convey events which occur outside NetBox. Unlike the change log, which is typically limited in the amount of history it retains, journal entries never expire.
+
+Journal entries are treated as append-only records: changing any attribute except ` + "`tags`" + ` creates a new entry. By default, destroying the resource only removes it from the Terraform state and keeps the entry in NetBox. Set ` + "`delete_on_destroy`" + ` to remove it from NetBox as well.`,
+
+		Schema: map[string]*schema.Schema{
+			"assigned_object_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The object type of the object the entry is written to, e.g. `dcim.device`.",
+			},
+			"assigned_object_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"kind": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "info",
+				ValidateFunc: validation.StringInSlice(resourceNetboxJournalEntryKindOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxJournalEntryKindOptions),
+			},
+			"comments": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The content of the entry. Supports Markdown.",
+			},
+			"delete_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the entry is deleted from NetBox when the resource is destroyed. Otherwise, it is only removed from the Terraform state.",
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getJournalEntryFromResourceData(api *providerState, d *schema.ResourceData) (*models.WritableJournalEntry, error) {
+	assignedObjectType := d.Get("assigned_object_type").(string)
+	assignedObjectID := int64(d.Get("assigned_object_id").(int))
+	comments := d.Get("comments").(string)
+
+	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.WritableJournalEntry{
+		AssignedObjectType: &assignedObjectType,
+		AssignedObjectID:   &assignedObjectID,
+		Kind:               d.Get("kind").(string),
+		Comments:           &comments,
+		Tags:               tags,
+	}, nil
+}
+
+func resourceNetboxJournalEntryCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data, err := getJournalEntryFromResourceData(api, d)
+	if err != nil {
+		return err
+	}
+
+	params := extras.NewExtrasJournalEntriesCreateParams().WithData(data)
+
+	res, err := api.Extras.ExtrasJournalEntriesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxJournalEntryRead(d, m)
+}
+
+func resourceNetboxJournalEntryRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := extras.NewExtrasJournalEntriesReadParams().WithID(id)
+
+	res, err := api.Extras.ExtrasJournalEntriesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*extras.ExtrasJournalEntriesReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	entry := res.GetPayload()
+
+	d.Set("assigned_object_type", entry.AssignedObjectType)
+	d.Set("assigned_object_id", entry.AssignedObjectID)
+	d.Set("comments", entry.Comments)
+	if entry.Kind != nil {
+		d.Set("kind", entry.Kind.Value)
+	}
+	if entry.Created != nil {
+		d.Set("created", entry.Created.String())
+	}
+	d.Set("created_by", entry.CreatedBy)
+
+	// delete_on_destroy is not stored in NetBox, so imported entries keep the default
+	if _, ok := d.GetOk("delete_on_destroy"); !ok {
+		d.Set("delete_on_destroy", false)
+	}
+
+	api.readTags(d, entry.Tags)
+
+	return nil
+}
+
+func resourceNetboxJournalEntryUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	// All other attributes force a new entry, so only tags need to be written here
+	if d.HasChange(tagsAllKey) {
+		data, err := getJournalEntryFromResourceData(api, d)
+		if err != nil {
+			return err
+		}
+
+		params := extras.NewExtrasJournalEntriesUpdateParams().WithID(id).WithData(data)
+
+		_, err = api.Extras.ExtrasJournalEntriesUpdate(params, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceNetboxJournalEntryRead(d, m)
+}
+
+func resourceNetboxJournalEntryDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	if !d.Get("delete_on_destroy").(bool) {
+		d.SetId("")
+		return nil
+	}
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := extras.NewExtrasJournalEntriesDeleteParams().WithID(id)
+
+	_, err := api.Extras.ExtrasJournalEntriesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*extras.ExtrasJournalEntriesDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxJournalEntry_basic(t *testing.T) {
+	testSlug := "journal_entry_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_journal_entry" "test" {
+  assigned_object_type = "dcim.site"
+  assigned_object_id   = netbox_site.test.id
+  kind                 = "success"
+  comments             = "Deployed by pipeline run **%[1]s**"
+  delete_on_destroy    = true
+  tags                 = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_journal_entry.test", "assigned_object_type", "dcim.site"),
+					resource.TestCheckResourceAttrPair("netbox_journal_entry.test", "assigned_object_id", "netbox_site.test", "id"),
+					resource.TestCheckResourceAttr("netbox_journal_entry.test", "kind", "success"),
+					resource.TestCheckResourceAttr("netbox_journal_entry.test", "comments", "Deployed by pipeline run **"+testName+"**"),
+					resource.TestCheckResourceAttr("netbox_journal_entry.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_journal_entry.test", "tags.0", testName),
+					resource.TestCheckResourceAttrSet("netbox_journal_entry.test", "created"),
+				),
+			},
+			{
+				ResourceName:            "netbox_journal_entry.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_on_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccNetboxJournalEntry_keepOnDestroy(t *testing.T) {
+	testSlug := "journal_entry_keep"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_journal_entry" "test" {
+  assigned_object_type = "dcim.site"
+  assigned_object_id   = netbox_site.test.id
+  comments             = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_journal_entry.test", "kind", "info"),
+					resource.TestCheckResourceAttr("netbox_journal_entry.test", "delete_on_destroy", "false"),
+				),
+			},
+			{
+				// Removing the entry from the configuration must keep it in NetBox
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+data "netbox_journal_entries" "test" {
+  filter {
+    name  = "assigned_object_type"
+    value = "dcim.site"
+  }
+  filter {
+    name  = "assigned_object_id"
+    value = netbox_site.test.id
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_journal_entries.test", "journal_entries.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_journal_entries.test", "journal_entries.0.comments", testName),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,176 @@
+package netbox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type notificationGroup struct {
+	ID          int64             `json:"id,omitempty"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Groups      []rawNestedObject `json:"groups"`
+	Users       []rawNestedObject `json:"users"`
+}
+
+type writableNotificationGroup struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Groups      []int64 `json:"groups"`
+	Users       []int64 `json:"users"`
+}
+
+func resourceNetboxNotificationGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxNotificationGroupCreate,
+		Read:   resourceNetboxNotificationGroupRead,
+		Update: resourceNetboxNotificationGroupUpdate,
+		Delete: resourceNetboxNotificationGroupDelete,
+
+		Description: `:meta:subcategory:Extras:From the [official documentation](https://netboxlabs.com/docs/netbox/models/extras/notificationgroup/):
+
+> A set of NetBox users and/or groups of users identified as recipients for certain notifications.
+
+Notification groups can be the target of event rules with the ` + "`notification`" + ` action type. Requires NetBox 4.1 or later.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"user_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				AtLeastOneOf: []string{"user_ids", "group_ids"},
+			},
+			"group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				AtLeastOneOf: []string{"user_ids", "group_ids"},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getNotificationGroupFromResourceData(d *schema.ResourceData) *writableNotificationGroup {
+	return &writableNotificationGroup{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Groups:      toInt64List(d.Get("group_ids")),
+		Users:       toInt64List(d.Get("user_ids")),
+	}
+}
+
+func resourceNetboxNotificationGroupCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	var res notificationGroup
+	err := netboxRawRequest(api, "POST", "/extras/notification-groups/", nil, getNotificationGroupFromResourceData(d), &res)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxNotificationGroupRead(d, m)
+}
+
+func resourceNetboxNotificationGroupRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	var group notificationGroup
+	err := netboxRawRequest(api, "GET", fmt.Sprintf("/extras/notification-groups/%s/", d.Id()), nil, nil, &group)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("name", group.Name)
+	d.Set("description", group.Description)
+
+	userIDs := make([]int64, 0, len(group.Users))
+	for _, user := range group.Users {
+		userIDs = append(userIDs, user.ID)
+	}
+	d.Set("user_ids", userIDs)
+
+	groupIDs := make([]int64, 0, len(group.Groups))
+	for _, g := range group.Groups {
+		groupIDs = append(groupIDs, g.ID)
+	}
+	d.Set("group_ids", groupIDs)
+
+	return nil
+}
+
+func resourceNetboxNotificationGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	err := netboxRawRequest(api, "PUT", fmt.Sprintf("/extras/notification-groups/%s/", d.Id()), nil, getNotificationGroupFromResourceData(d), nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxNotificationGroupRead(d, m)
+}
+
+func resourceNetboxNotificationGroupDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	err := netboxRawRequest(api, "DELETE", fmt.Sprintf("/extras/notification-groups/%s/", d.Id()), nil, nil, nil)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func getNotificationGroupIDByName(api *providerState, name string) (int64, error) {
+	query := url.Values{}
+	query.Set("name", name)
+	query.Set("limit", "2")
+
+	var page rawListPage[notificationGroup]
+	if err := netboxRawRequest(api, "GET", "/extras/notification-groups/", query, nil, &page); err != nil {
+		return 0, err
+	}
+
+	if page.Count > int64(1) {
+		return 0, errors.New("more than one notification group returned, specify a more narrow filter")
+	}
+	if page.Count == int64(0) {
+		return 0, &objectNameNotFoundError{objectName: "notification group", name: name}
+	}
+	return page.Results[0].ID, nil
+}
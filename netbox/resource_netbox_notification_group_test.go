@@ -0,0 +1,65 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxNotificationGroup_basic(t *testing.T) {
+	testName := testAccGetTestName("notification_group")
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_user" "test" {
+  username = "%[1]s"
+  password = "Abcdefghijkl1"
+}
+
+resource "netbox_notification_group" "test" {
+  name        = "%[1]s"
+  description = "%[1]s description"
+  user_ids    = [netbox_user.test.id]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_notification_group.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_notification_group.test", "description", testName+" description"),
+					resource.TestCheckResourceAttr("netbox_notification_group.test", "user_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("netbox_notification_group.test", "user_ids.0", "netbox_user.test", "id"),
+					resource.TestCheckResourceAttr("netbox_notification_group.test", "group_ids.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_user" "test" {
+  username = "%[1]s"
+  password = "Abcdefghijkl1"
+}
+
+resource "netbox_group" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_notification_group" "test" {
+  name      = "%[1]s"
+  group_ids = [netbox_group.test.id]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_notification_group.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_notification_group.test", "user_ids.#", "0"),
+					resource.TestCheckResourceAttr("netbox_notification_group.test", "group_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("netbox_notification_group.test", "group_ids.0", "netbox_group.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_notification_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
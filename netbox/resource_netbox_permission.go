@@ -1,15 +1,27 @@
 package netbox
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/fbreckle/go-netbox/netbox/client/users"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// resourceNetboxPermissionActionOptions are the standard actions and the
+// custom actions used by NetBox itself. Plugins can define further actions, so
+// other values only cause a warning.
+var resourceNetboxPermissionActionOptions = []string{"view", "add", "change", "delete", "run", "sync", "render_config"}
+
 func resourceNetboxPermission() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetboxPermissionCreate,
@@ -41,7 +53,8 @@ func resourceNetboxPermission() *schema.Resource {
 			"object_types": {
 				Type: schema.TypeSet,
 				Description: "A list of object types that the permission object allows access to. Should be in a form " +
-					"the API can accept. For example: `circuits.provider`, `dcim.inventoryitem`, etc.",
+					"the API can accept. For example: `circuits.provider`, `dcim.inventoryitem`, etc. The values are validated against the " +
+					"content types known to NetBox, see the `netbox_content_types` data source.",
 				Required: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -66,24 +79,58 @@ func resourceNetboxPermission() *schema.Resource {
 			"actions": {
 				Type:        schema.TypeSet,
 				Required:    true,
-				Description: "A list actions that are allowed on the object types. Acceptable values are `view`, `add`, `change`, or `delete`.",
+				Description: "A list actions that are allowed on the object types. Besides the standard actions `view`, `add`, `change` and `delete`, NetBox itself uses the custom actions `run`, `sync` and `render_config`. Other custom actions, e.g. of plugins, are accepted with a warning.",
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validatePermissionAction,
 				},
 			},
 			"constraints": {
 				Type: schema.TypeString,
 				Description: "A JSON string of an arbitrary filter used to limit the granted action(s) to a specific subset of objects. " +
 					"For more information on correct syntax, see https://docs.netbox.dev/en/stable/administration/permissions/#constraints ",
-				Optional:     true,
-				ValidateFunc: validation.StringIsJSON,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsJSON,
+				ConflictsWith: []string{"constraint"},
+			},
+			"constraint": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"constraints"},
+				Description:   "Limits the granted action(s) to a specific subset of objects. An object must match all conditions of at least one `constraint` block. This is an alternative to the `constraints` JSON string.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"condition": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The field to filter on, including an optional lookup, e.g. `status`, `tenant__group__slug` or `vid__lt`.",
+									},
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsJSON,
+										Description:  "The JSON encoded value the field must match, e.g. `jsonencode(\"active\")`, `jsonencode(true)`, `jsonencode(null)` or `jsonencode([\"dc1\", \"dc2\"])` for lookups like `__in`. Use `jsonencode(\"$user\")` to match the user the permission is evaluated for.",
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
+		CustomizeDiff: resourceNetboxPermissionCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
+
 func resourceNetboxPermissionCreate(d *schema.ResourceData, m interface{}) error {
 	api := m.(*providerState)
 	data := models.WritableObjectPermission{}
@@ -98,22 +145,11 @@ func resourceNetboxPermissionCreate(d *schema.ResourceData, m interface{}) error
 	data.Users = toInt64List(d.Get("users"))
 	data.Actions = toStringList(d.Get("actions"))
 
-	var constraints interface{}
-	c := d.Get("constraints").(string)
-	if c == "" {
-		data.Constraints = nil
-	} else {
-		err := json.Unmarshal([]byte(c), &constraints)
-		if err != nil {
-			return err
-		}
-		switch v := constraints.(type) {
-		case []interface{}:
-			data.Constraints = v
-		case map[string]interface{}:
-			data.Constraints = v
-		}
+	constraints, err := getPermissionConstraints(d)
+	if err != nil {
+		return err
 	}
+	data.Constraints = constraints
 
 	params := users.NewUsersPermissionsCreateParams().WithData(&data)
 	res, err := api.Users.UsersPermissionsCreate(params, nil)
@@ -164,14 +200,26 @@ func resourceNetboxPermissionRead(d *schema.ResourceData, m interface{}) error {
 
 	if res.GetPayload().Constraints == nil {
 		d.Set("constraints", "")
+		d.Set("constraint", nil)
 		return nil
 	}
 
+	// Keep the constraint blocks if they are used, unless the constraints
+	// cannot be expressed as blocks (e.g. when changed outside of terraform)
+	if len(d.Get("constraint").([]interface{})) > 0 {
+		if constraint, err := flattenPermissionConstraint(res.GetPayload().Constraints, d.Get("constraint").([]interface{})); err == nil {
+			d.Set("constraint", constraint)
+			d.Set("constraints", "")
+			return nil
+		}
+	}
+
 	b, err := json.Marshal(res.GetPayload().Constraints)
 	if err != nil {
 		return err
 	}
 	d.Set("constraints", string(b))
+	d.Set("constraint", nil)
 
 	return nil
 }
@@ -191,30 +239,166 @@ func resourceNetboxPermissionUpdate(d *schema.ResourceData, m interface{}) error
 	data.Users = toInt64List(d.Get("users"))
 	data.Actions = toStringList(d.Get("actions"))
 
-	var constraints interface{}
-	c := d.Get("constraints").(string)
-	if c == "" {
-		data.Constraints = nil
-	} else {
-		err := json.Unmarshal([]byte(c), &constraints)
-		if err != nil {
-			return err
-		}
-		switch v := constraints.(type) {
-		case []interface{}:
-			data.Constraints = v
-		case map[string]interface{}:
-			data.Constraints = v
-		}
+	constraints, err := getPermissionConstraints(d)
+	if err != nil {
+		return err
 	}
+	data.Constraints = constraints
 	params := users.NewUsersPermissionsUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Users.UsersPermissionsUpdate(params, nil)
+	_, err = api.Users.UsersPermissionsUpdate(params, nil)
 	if err != nil {
 		return err
 	}
 	return resourceNetboxPermissionRead(d, m)
 }
 
+// resourceNetboxPermissionCustomizeDiff validates changed object types against
+// the content types known to NetBox.
+func resourceNetboxPermissionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	api, ok := m.(*providerState)
+	if !ok || !d.HasChange("object_types") || !d.NewValueKnown("object_types") {
+		return nil
+	}
+
+	objectTypeNames, err := getObjectTypeNames(api)
+	if err != nil {
+		// Validation is best effort, e.g. the token might not be allowed to list object types
+		log.Printf("[WARN] could not fetch object types, skipping validation of object_types: %s", err)
+		return nil
+	}
+
+	var invalid []string
+	for _, objectType := range toStringList(d.Get("object_types")) {
+		if !slices.Contains(objectTypeNames, objectType) {
+			invalid = append(invalid, objectType)
+		}
+	}
+	if len(invalid) > 0 {
+		slices.Sort(invalid)
+		return fmt.Errorf("object_types contains unknown content types: %s. Use the netbox_content_types data source to list the valid values", strings.Join(invalid, ", "))
+	}
+	return nil
+}
+
+// validatePermissionAction warns about actions that NetBox does not define
+// itself. They are likely typos, but might as well be custom actions of plugins.
+func validatePermissionAction(value interface{}, path cty.Path) diag.Diagnostics {
+	action := value.(string)
+	if slices.Contains(resourceNetboxPermissionActionOptions, action) {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity:      diag.Warning,
+		Summary:       fmt.Sprintf("Unknown action %q", action),
+		Detail:        fmt.Sprintf("%q is not an action of NetBox itself. Unless it is a custom action of a plugin, check it for typos. %s", action, buildValidValueDescription(resourceNetboxPermissionActionOptions)),
+		AttributePath: path,
+	}}
+}
+
+// getPermissionConstraints returns the constraints from either the
+// `constraints` JSON string or the `constraint` blocks.
+func getPermissionConstraints(d *schema.ResourceData) (interface{}, error) {
+	if constraint := d.Get("constraint").([]interface{}); len(constraint) > 0 {
+		return expandPermissionConstraint(constraint)
+	}
+
+	c := d.Get("constraints").(string)
+	if c == "" {
+		return nil, nil
+	}
+
+	var constraints interface{}
+	if err := json.Unmarshal([]byte(c), &constraints); err != nil {
+		return nil, err
+	}
+	switch v := constraints.(type) {
+	case []interface{}:
+		return v, nil
+	case map[string]interface{}:
+		return v, nil
+	}
+	return nil, nil
+}
+
+// expandPermissionConstraint compiles the `constraint` blocks to the NetBox
+// constraint format: a list of objects which are OR'ed, each of them matching
+// all of its attributes.
+func expandPermissionConstraint(constraint []interface{}) ([]interface{}, error) {
+	constraints := make([]interface{}, 0, len(constraint))
+	for _, block := range constraint {
+		filter := make(map[string]interface{})
+		for _, c := range block.(map[string]interface{})["condition"].(*schema.Set).List() {
+			condition := c.(map[string]interface{})
+			field := condition["field"].(string)
+
+			if _, ok := filter[field]; ok {
+				return nil, fmt.Errorf("field %q is used more than once in the same constraint block", field)
+			}
+
+			// The value is decoded, so that NetBox gets booleans, numbers
+			// and nulls instead of their string representation
+			var value interface{}
+			if err := json.Unmarshal([]byte(condition["value"].(string)), &value); err != nil {
+				return nil, fmt.Errorf("value of field %q must be JSON encoded, e.g. with jsonencode(): %w", field, err)
+			}
+			filter[field] = value
+		}
+		constraints = append(constraints, filter)
+	}
+	return constraints, nil
+}
+
+// flattenPermissionConstraint converts NetBox constraints to `constraint`
+// blocks. Values that are semantically equal to the value of the same field in
+// the current blocks keep their current encoding. It fails for constraints that
+// cannot be expressed as blocks.
+func flattenPermissionConstraint(constraints interface{}, current []interface{}) ([]interface{}, error) {
+	var filters []interface{}
+	switch v := constraints.(type) {
+	case []interface{}:
+		filters = v
+	case map[string]interface{}:
+		filters = []interface{}{v}
+	default:
+		return nil, fmt.Errorf("unexpected constraints type %T", constraints)
+	}
+
+	constraint := make([]interface{}, 0, len(filters))
+	for i, f := range filters {
+		filter, ok := f.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected constraint type %T", f)
+		}
+
+		currentValues := make(map[string]string)
+		if i < len(current) {
+			if block, ok := current[i].(map[string]interface{}); ok {
+				for _, c := range block["condition"].(*schema.Set).List() {
+					condition := c.(map[string]interface{})
+					currentValues[condition["field"].(string)] = condition["value"].(string)
+				}
+			}
+		}
+
+		conditions := make([]interface{}, 0, len(filter))
+		for field, v := range filter {
+			b, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			value := string(b)
+			if currentValue, ok := currentValues[field]; ok {
+				if equal, err := jsonSemanticCompare(currentValue, value); err == nil && equal {
+					value = currentValue
+				}
+			}
+			conditions = append(conditions, map[string]interface{}{"field": field, "value": value})
+		}
+		constraint = append(constraint, map[string]interface{}{"condition": conditions})
+	}
+	return constraint, nil
+}
+
 func resourceNetboxPermissionDelete(d *schema.ResourceData, m interface{}) error {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -3,11 +3,16 @@ package netbox
 import (
 	"fmt"
 	"log"
+	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/fbreckle/go-netbox/netbox/client/users"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccNetboxPermission_basic(t *testing.T) {
@@ -83,6 +88,177 @@ resource "netbox_permission" "test_basic" {
 	})
 }
 
+func TestAccNetboxPermission_constraintBlocks(t *testing.T) {
+	testSlug := "user_perms_blocks"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_permission" "test" {
+  name         = "%s"
+  object_types = ["dcim.device", "extras.script"]
+  actions      = ["view", "change", "run", "approve"]
+
+  constraint {
+    condition {
+      field = "status"
+      value = jsonencode("active")
+    }
+    condition {
+      field = "site__slug__in"
+      value = jsonencode(["dc1", "dc2"])
+    }
+  }
+
+  constraint {
+    condition {
+      field = "owner"
+      value = jsonencode("$user")
+    }
+    condition {
+      field = "airflow__isnull"
+      value = jsonencode(true)
+    }
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_permission.test", "actions.#", "4"),
+					resource.TestCheckTypeSetElemAttr("netbox_permission.test", "actions.*", "approve"),
+					resource.TestCheckResourceAttr("netbox_permission.test", "constraints", ""),
+					resource.TestCheckResourceAttr("netbox_permission.test", "constraint.#", "2"),
+					resource.TestCheckResourceAttr("netbox_permission.test", "constraint.0.condition.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("netbox_permission.test", "constraint.0.condition.*", map[string]string{
+						"field": "site__slug__in",
+						"value": `["dc1","dc2"]`,
+					}),
+					resource.TestCheckResourceAttr("netbox_permission.test", "constraint.1.condition.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("netbox_permission.test", "constraint.1.condition.*", map[string]string{
+						"field": "owner",
+						"value": `"$user"`,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("netbox_permission.test", "constraint.1.condition.*", map[string]string{
+						"field": "airflow__isnull",
+						"value": "true",
+					}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_permission" "test" {
+  name         = "%s"
+  object_types = ["dcim.device"]
+  actions      = ["view"]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_permission.test", "actions.#", "1"),
+					resource.TestCheckResourceAttr("netbox_permission.test", "constraints", ""),
+					resource.TestCheckResourceAttr("netbox_permission.test", "constraint.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxPermission_invalidObjectType(t *testing.T) {
+	testSlug := "user_perms_invalid"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_permission" "test" {
+  name         = "%s"
+  object_types = ["dcim.device", "dcim.devcie"]
+  actions      = ["view"]
+}`, testName),
+				ExpectError: regexp.MustCompile("object_types contains unknown content types: dcim.devcie"),
+			},
+		},
+	})
+}
+
+func TestValidatePermissionAction(t *testing.T) {
+	path := cty.GetAttrPath("actions")
+
+	if diags := validatePermissionAction("run", path); len(diags) != 0 {
+		t.Errorf("expected no diagnostics for a NetBox action, got %v", diags)
+	}
+
+	diags := validatePermissionAction("approve", path)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("expected a single warning for a custom action, got %v", diags)
+	}
+}
+
+// testPermissionConstraintBlocks builds constraint blocks like terraform
+// provides them.
+func testPermissionConstraintBlocks(constraint []interface{}) []interface{} {
+	conditionSchema := resourceNetboxPermission().Schema["constraint"].Elem.(*schema.Resource).Schema["condition"]
+	var blocks []interface{}
+	for _, block := range constraint {
+		blocks = append(blocks, map[string]interface{}{
+			"condition": schema.NewSet(schema.HashResource(conditionSchema.Elem.(*schema.Resource)), block.(map[string]interface{})["condition"].([]interface{})),
+		})
+	}
+	return blocks
+}
+
+func TestPermissionConstraint(t *testing.T) {
+	constraints := []interface{}{
+		map[string]interface{}{"status": "active", "site__slug__in": []interface{}{"dc1", "dc2"}},
+		map[string]interface{}{"owner": "$user", "vid__lt": float64(100), "is_active": true, "tenant__isnull": nil},
+	}
+
+	constraint, err := flattenPermissionConstraint(constraints, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := expandPermissionConstraint(testPermissionConstraintBlocks(constraint))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// JSON types survive the round trip
+	if !reflect.DeepEqual(actual, constraints) {
+		t.Fatalf("expected %#v, got %#v", constraints, actual)
+	}
+}
+
+func TestPermissionConstraint_keepsEncoding(t *testing.T) {
+	current := testPermissionConstraintBlocks([]interface{}{
+		map[string]interface{}{"condition": []interface{}{
+			map[string]interface{}{"field": "site__slug__in", "value": `[ "dc1", "dc2" ]`},
+			map[string]interface{}{"field": "vid__lt", "value": "100"},
+		}},
+	})
+	constraints := []interface{}{
+		map[string]interface{}{"site__slug__in": []interface{}{"dc1", "dc2"}, "vid__lt": float64(200)},
+	}
+
+	constraint, err := flattenPermissionConstraint(constraints, current)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values := make(map[string]string)
+	for _, c := range constraint[0].(map[string]interface{})["condition"].([]interface{}) {
+		condition := c.(map[string]interface{})
+		values[condition["field"].(string)] = condition["value"].(string)
+	}
+	// Semantically equal values keep the configured encoding, changed ones are
+	// updated
+	expected := map[string]string{"site__slug__in": `[ "dc1", "dc2" ]`, "vid__lt": "200"}
+	if !reflect.DeepEqual(values, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, values)
+	}
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_permission", &resource.Sweeper{
 		Name:         "netbox_permission",
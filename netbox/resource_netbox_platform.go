@@ -35,6 +35,10 @@ func resourceNetboxPlatform() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"config_template_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -42,6 +46,18 @@ func resourceNetboxPlatform() *schema.Resource {
 	}
 }
 
+// platformConfigTemplate captures the config template of a platform, which
+// is missing from the go-netbox model.
+type platformConfigTemplate struct {
+	ConfigTemplate *rawNestedObject `json:"config_template"`
+}
+
+func getPlatformConfigTemplateFields(d *schema.ResourceData) map[string]any {
+	return map[string]any{
+		"config_template": getOptionalInt(d, "config_template_id"),
+	}
+}
+
 func resourceNetboxPlatformCreate(d *schema.ResourceData, m interface{}) error {
 	api := m.(*providerState)
 
@@ -69,7 +85,7 @@ func resourceNetboxPlatformCreate(d *schema.ResourceData, m interface{}) error {
 
 	params := dcim.NewDcimPlatformsCreateParams().WithData(&data)
 
-	res, err := api.Dcim.DcimPlatformsCreate(params, nil)
+	res, err := api.Dcim.DcimPlatformsCreate(params, nil, hackSerializeWithValues(getPlatformConfigTemplateFields(d)))
 	if err != nil {
 		//return errors.New(getTextFromError(err))
 		return err
@@ -85,7 +101,8 @@ func resourceNetboxPlatformRead(d *schema.ResourceData, m interface{}) error {
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimPlatformsReadParams().WithID(id)
 
-	res, err := api.Dcim.DcimPlatformsRead(params, nil)
+	var configTemplate platformConfigTemplate
+	res, err := api.Dcim.DcimPlatformsRead(params, nil, withRawResponse(&configTemplate))
 
 	if err != nil {
 		if errresp, ok := err.(*dcim.DcimPlatformsReadDefault); ok {
@@ -106,6 +123,11 @@ func resourceNetboxPlatformRead(d *schema.ResourceData, m interface{}) error {
 	if result.Manufacturer != nil {
 		d.Set("manufacturer_id", result.Manufacturer.ID)
 	}
+	if configTemplate.ConfigTemplate != nil {
+		d.Set("config_template_id", configTemplate.ConfigTemplate.ID)
+	} else {
+		d.Set("config_template_id", nil)
+	}
 	return nil
 }
 
@@ -137,7 +159,7 @@ func resourceNetboxPlatformUpdate(d *schema.ResourceData, m interface{}) error {
 
 	params := dcim.NewDcimPlatformsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimPlatformsPartialUpdate(params, nil)
+	_, err := api.Dcim.DcimPlatformsPartialUpdate(params, nil, hackSerializeWithValues(getPlatformConfigTemplateFields(d)))
 	if err != nil {
 		return err
 	}
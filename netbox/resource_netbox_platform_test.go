@@ -38,6 +38,51 @@ resource "netbox_platform" "test" {
 	})
 }
 
+func TestAccNetboxPlatform_configTemplate(t *testing.T) {
+	testSlug := "platform_cfg_tmpl"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_config_template" "test" {
+  name          = "%[1]s"
+  template_code = "hostname={{ device.name }}"
+}
+
+resource "netbox_platform" "test" {
+  name               = "%[1]s"
+  config_template_id = netbox_config_template.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_platform.test", "config_template_id", "netbox_config_template.test", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_config_template" "test" {
+  name          = "%[1]s"
+  template_code = "hostname={{ device.name }}"
+}
+
+resource "netbox_platform" "test" {
+  name = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_platform.test", "config_template_id", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_platform.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccNetboxPlatform_manufacturer(t *testing.T) {
 	testSlug := "platform_manufacturer"
 	testName := testAccGetTestName(testSlug)
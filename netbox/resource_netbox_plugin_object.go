@@ -0,0 +1,358 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/slices"
+)
+
+// pluginObjectReadOnlyFields are returned by the API for every object, but
+// cannot be written. They are left out of the attributes of imported objects.
+var pluginObjectReadOnlyFields = []string{"id", "url", "display_url", "display", "created", "last_updated"}
+
+func resourceNetboxPluginObject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxPluginObjectCreate,
+		ReadContext:   resourceNetboxPluginObjectRead,
+		UpdateContext: resourceNetboxPluginObjectUpdate,
+		DeleteContext: resourceNetboxPluginObjectDelete,
+		CustomizeDiff: resourceNetboxPluginObjectCustomizeDiff,
+
+		Description: `:meta:subcategory:Plugins:Manages an object of a NetBox plugin without dedicated resource, e.g. a zone of [netbox-dns](https://github.com/peteeckel/netbox-plugin-dns), through the REST API of the plugin.
+
+Related objects can be referenced by their ID, choices by their value. When the object is read back, nested objects and choices in the response are reduced to their ID or value accordingly, and only the attributes set in the configuration are compared.`,
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile(`^/?(api/)?[a-z0-9_-]+(/[a-z0-9_-]+)+/?$`), "must be an API path like plugins/netbox-dns/zones")),
+				StateFunc: func(v interface{}) string {
+					return normalizePluginObjectPath(v.(string))
+				},
+				Description: "The API path of the object list, relative to `/api/`, e.g. `plugins/netbox-dns/zones`.",
+			},
+			"attributes": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsJSON),
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					equal, _ := jsonSemanticCompare(oldValue, newValue)
+					return equal
+				},
+				DiffSuppressOnRefresh: true,
+				Description:           "The fields of the object as JSON object, usually created with `jsonencode`. Fields that are not set are left to the plugin and not compared.",
+			},
+			"validate_schema": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, check `path` and the names of the `attributes` against the OpenAPI schema of NetBox at plan time. The schema is large, so it is only fetched once per provider.",
+			},
+			"response": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The full object as returned by the API, as JSON, e.g. for use with `jsondecode` to access computed fields.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNetboxPluginObjectImport,
+		},
+	}
+}
+
+// normalizePluginObjectPath turns the user supplied path into the form used
+// in the state, e.g. "plugins/netbox-dns/zones".
+func normalizePluginObjectPath(path string) string {
+	return strings.TrimPrefix(strings.Trim(path, "/"), "api/")
+}
+
+// pluginObjectURL returns the API path of the list, or of the object with the
+// given ID.
+func pluginObjectURL(path string, id string) string {
+	path = "/" + normalizePluginObjectPath(path) + "/"
+	if id != "" {
+		path += id + "/"
+	}
+	return path
+}
+
+func getPluginObjectAttributes(d *schema.ResourceData) (map[string]interface{}, error) {
+	var attributes map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("attributes").(string)), &attributes); err != nil {
+		return nil, fmt.Errorf("attributes must be a JSON object: %w", err)
+	}
+	return attributes, nil
+}
+
+func resourceNetboxPluginObjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	attributes, err := getPluginObjectAttributes(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var res map[string]interface{}
+	if err := netboxRawRequest(api, "POST", pluginObjectURL(d.Get("path").(string), ""), nil, attributes, &res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the API consumer decodes numbers as json.Number
+	id, ok := res["id"].(json.Number)
+	if !ok {
+		return diag.Errorf("the response of %s contains no object ID", pluginObjectURL(d.Get("path").(string), ""))
+	}
+	d.SetId(id.String())
+
+	return resourceNetboxPluginObjectRead(ctx, d, m)
+}
+
+func resourceNetboxPluginObjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var res map[string]interface{}
+	if err := netboxRawRequest(api, "GET", pluginObjectURL(d.Get("path").(string), d.Id()), nil, nil, &res); err != nil {
+		if apiErr, ok := err.(*netboxRawAPIError); ok && apiErr.Code() == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	response, err := json.Marshal(res)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("response", string(response))
+
+	// Imported objects have no attributes yet, take all writable fields
+	configured := map[string]interface{}{}
+	if d.Get("attributes").(string) != "" {
+		configured, err = getPluginObjectAttributes(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		for key := range res {
+			if !slices.Contains(pluginObjectReadOnlyFields, key) {
+				configured[key] = nil
+			}
+		}
+	}
+
+	attributes := make(map[string]interface{}, len(configured))
+	for key, value := range configured {
+		actual, ok := res[key]
+		if !ok {
+			// e.g. write-only fields like passwords
+			attributes[key] = value
+			continue
+		}
+		attributes[key] = normalizePluginObjectValue(value, actual)
+	}
+
+	// Keep the configured formatting if nothing changed
+	normalized, err := json.Marshal(attributes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if equal, _ := jsonSemanticCompare(d.Get("attributes").(string), string(normalized)); !equal {
+		d.Set("attributes", string(normalized))
+	}
+
+	return nil
+}
+
+// normalizePluginObjectValue reduces the value returned by the API to the form
+// of the configured value. Nested objects become their ID and choices their
+// value, unless the configuration contains an object as well.
+func normalizePluginObjectValue(configured, actual interface{}) interface{} {
+	switch actualValue := actual.(type) {
+	case map[string]interface{}:
+		if configuredValue, ok := configured.(map[string]interface{}); ok {
+			normalized := make(map[string]interface{}, len(configuredValue))
+			for key, value := range configuredValue {
+				if nested, ok := actualValue[key]; ok {
+					normalized[key] = normalizePluginObjectValue(value, nested)
+				} else {
+					normalized[key] = value
+				}
+			}
+			return normalized
+		}
+		if value, ok := actualValue["value"]; ok {
+			if _, ok := actualValue["label"]; ok {
+				return value
+			}
+		}
+		if id, ok := actualValue["id"]; ok {
+			return id
+		}
+		return actualValue
+	case []interface{}:
+		configuredValues, _ := configured.([]interface{})
+		normalized := make([]interface{}, len(actualValue))
+		for i, value := range actualValue {
+			var template interface{}
+			if i < len(configuredValues) {
+				template = configuredValues[i]
+			} else if len(configuredValues) > 0 {
+				template = configuredValues[0]
+			}
+			normalized[i] = normalizePluginObjectValue(template, value)
+		}
+		return normalized
+	default:
+		return actual
+	}
+}
+
+func resourceNetboxPluginObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	attributes, err := getPluginObjectAttributes(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// PATCH leaves the fields that are not configured to the plugin
+	if err := netboxRawRequest(api, "PATCH", pluginObjectURL(d.Get("path").(string), d.Id()), nil, attributes, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxPluginObjectRead(ctx, d, m)
+}
+
+func resourceNetboxPluginObjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	if err := netboxRawRequest(api, "DELETE", pluginObjectURL(d.Get("path").(string), d.Id()), nil, nil, nil); err != nil {
+		if apiErr, ok := err.(*netboxRawAPIError); ok && apiErr.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceNetboxPluginObjectImport imports objects by their path and ID, e.g.
+// "plugins/netbox-dns/zones/12".
+func resourceNetboxPluginObjectImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := normalizePluginObjectPath(d.Id())
+	separator := strings.LastIndex(importID, "/")
+	if separator < 0 {
+		return nil, fmt.Errorf("invalid import ID %q, expected <path>/<id>, e.g. plugins/netbox-dns/zones/12", d.Id())
+	}
+	if _, err := strconv.ParseInt(importID[separator+1:], 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid import ID %q, expected <path>/<id>, e.g. plugins/netbox-dns/zones/12", d.Id())
+	}
+
+	d.SetId(importID[separator+1:])
+	d.Set("path", importID[:separator])
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceNetboxPluginObjectCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.Get("validate_schema").(bool) || !d.NewValueKnown("path") || !d.NewValueKnown("attributes") {
+		return nil
+	}
+
+	var attributes map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("attributes").(string)), &attributes); err != nil {
+		return fmt.Errorf("attributes must be a JSON object: %w", err)
+	}
+
+	fields, err := getPluginObjectFields(m.(*providerState), d.Get("path").(string))
+	if err != nil {
+		return err
+	}
+
+	var unknown []string
+	for key := range attributes {
+		if !slices.Contains(fields, key) {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("%s are not fields of %s, valid fields are %s", joinStringWithFinalConjunction(unknown, ", ", "and"), pluginObjectURL(d.Get("path").(string), ""), joinStringWithFinalConjunction(fields, ", ", "and"))
+	}
+	return nil
+}
+
+// openAPISchema is the part of the OpenAPI schema of NetBox that is needed to
+// validate plugin objects.
+type openAPISchema struct {
+	Paths map[string]map[string]struct {
+		RequestBody struct {
+			Content map[string]struct {
+				Schema openAPISchemaRef `json:"schema"`
+			} `json:"content"`
+		} `json:"requestBody"`
+	} `json:"paths"`
+	Components struct {
+		Schemas map[string]struct {
+			Properties map[string]json.RawMessage `json:"properties"`
+		} `json:"schemas"`
+	} `json:"components"`
+}
+
+type openAPISchemaRef struct {
+	Ref string `json:"$ref"`
+}
+
+func getOpenAPISchema(api *providerState) (*openAPISchema, error) {
+	if api.openAPISchema == nil {
+		api.openAPISchema = &openAPISchemaCache{}
+	}
+	cache := api.openAPISchema
+	cache.once.Do(func() {
+		cache.err = netboxRawRequest(api, "GET", "/schema/", url.Values{"format": []string{"json"}}, nil, &cache.schema)
+	})
+	return &cache.schema, cache.err
+}
+
+// getPluginObjectFields returns the sorted names of the fields that can be
+// written to objects of the given path.
+func getPluginObjectFields(api *providerState, path string) ([]string, error) {
+	doc, err := getOpenAPISchema(api)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching the OpenAPI schema: %w", err)
+	}
+
+	listPath := "/api" + pluginObjectURL(path, "")
+	operations, ok := doc.Paths[listPath]
+	if !ok {
+		return nil, fmt.Errorf("%s is not part of the OpenAPI schema of NetBox, check the path and whether the plugin is installed", listPath)
+	}
+	body, ok := operations["post"].RequestBody.Content["application/json"]
+	if !ok {
+		return nil, fmt.Errorf("objects cannot be created at %s", listPath)
+	}
+
+	component, ok := doc.Components.Schemas[strings.TrimPrefix(body.Schema.Ref, "#/components/schemas/")]
+	if !ok {
+		return nil, fmt.Errorf("the OpenAPI schema of %s could not be resolved", listPath)
+	}
+
+	fields := make([]string, 0, len(component.Properties))
+	for field := range component.Properties {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
@@ -0,0 +1,170 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// newPluginObjectTestServer returns a mock NetBox with the netbox-dns plugin
+// that serves zone 7 and the OpenAPI schema of the zones.
+func newPluginObjectTestServer(t *testing.T) (*httptest.Server, *int) {
+	schemaRequests := 0
+	zone := map[string]interface{}{
+		"id":           7,
+		"url":          "https://netbox.example.com/api/plugins/netbox-dns/zones/7/",
+		"display":      "example.com",
+		"name":         "example.com",
+		"view":         map[string]interface{}{"id": 1, "name": "_default_", "url": "https://netbox.example.com/api/plugins/netbox-dns/views/1/"},
+		"status":       map[string]interface{}{"value": "active", "label": "Active"},
+		"nameservers":  []interface{}{map[string]interface{}{"id": 3, "name": "ns1.example.com"}},
+		"soa_serial":   2024010101,
+		"created":      "2024-01-01T00:00:00Z",
+		"last_updated": "2024-01-01T00:00:00Z",
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/plugins/netbox-dns/zones/":
+			var received map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+				t.Errorf("failed to decode zone request: %v", err)
+			}
+			assert.Equal(t, "example.com", received["name"])
+			assert.Equal(t, float64(1), received["view"])
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(zone)
+		case r.Method == "GET" && r.URL.Path == "/api/plugins/netbox-dns/zones/7/":
+			json.NewEncoder(w).Encode(zone)
+		case r.Method == "GET" && r.URL.Path == "/api/schema/":
+			schemaRequests++
+			assert.Equal(t, "json", r.URL.Query().Get("format"))
+			w.Header().Set("Content-Type", "application/vnd.oai.openapi+json")
+			w.Write([]byte(`{
+				"paths": {
+					"/api/plugins/netbox-dns/zones/": {
+						"post": {"requestBody": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/ZoneRequest"}}}}}
+					}
+				},
+				"components": {
+					"schemas": {
+						"ZoneRequest": {"properties": {"name": {"type": "string"}, "view": {}, "status": {}, "nameservers": {}}}
+					}
+				}
+			}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	return ts, &schemaRequests
+}
+
+func TestResourceNetboxPluginObjectCreate(t *testing.T) {
+	ts, _ := newPluginObjectTestServer(t)
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceNetboxPluginObject().Schema, map[string]interface{}{
+		"path":       "/api/plugins/netbox-dns/zones/",
+		"attributes": `{"name": "example.com", "view": 1, "status": "active", "nameservers": [3], "tsig_key": "secret"}`,
+	})
+
+	diags := resourceNetboxPluginObjectCreate(context.Background(), d, testMockProviderState(t, ts.URL))
+	if diags.HasError() {
+		t.Fatalf("resourceNetboxPluginObjectCreate returned error: %v", diags)
+	}
+
+	assert.Equal(t, "7", d.Id())
+	// nested objects and choices are reduced, write-only fields are kept
+	equal, err := jsonSemanticCompare(`{"name": "example.com", "view": 1, "status": "active", "nameservers": [3], "tsig_key": "secret"}`, d.Get("attributes").(string))
+	assert.NoError(t, err)
+	assert.True(t, equal, "unexpected attributes %s", d.Get("attributes"))
+
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(d.Get("response").(string)), &response))
+	assert.Equal(t, float64(2024010101), response["soa_serial"])
+}
+
+func TestResourceNetboxPluginObjectRead_import(t *testing.T) {
+	ts, _ := newPluginObjectTestServer(t)
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceNetboxPluginObject().Schema, map[string]interface{}{})
+	d.SetId("api/plugins/netbox-dns/zones/7")
+
+	imported, err := resourceNetboxPluginObjectImport(context.Background(), d, nil)
+	if err != nil {
+		t.Fatalf("resourceNetboxPluginObjectImport returned error: %v", err)
+	}
+	assert.Len(t, imported, 1)
+	assert.Equal(t, "7", d.Id())
+	assert.Equal(t, "plugins/netbox-dns/zones", d.Get("path"))
+
+	diags := resourceNetboxPluginObjectRead(context.Background(), d, testMockProviderState(t, ts.URL))
+	if diags.HasError() {
+		t.Fatalf("resourceNetboxPluginObjectRead returned error: %v", diags)
+	}
+
+	// all writable fields are taken over
+	equal, err := jsonSemanticCompare(`{"name": "example.com", "view": 1, "status": "active", "nameservers": [3], "soa_serial": 2024010101}`, d.Get("attributes").(string))
+	assert.NoError(t, err)
+	assert.True(t, equal, "unexpected attributes %s", d.Get("attributes"))
+}
+
+func TestResourceNetboxPluginObjectRead_notFound(t *testing.T) {
+	ts, _ := newPluginObjectTestServer(t)
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceNetboxPluginObject().Schema, map[string]interface{}{
+		"path":       "plugins/netbox-dns/zones",
+		"attributes": `{"name": "example.com"}`,
+	})
+	d.SetId("8")
+
+	diags := resourceNetboxPluginObjectRead(context.Background(), d, testMockProviderState(t, ts.URL))
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", d.Id())
+}
+
+func TestResourceNetboxPluginObjectImport_invalid(t *testing.T) {
+	for _, importID := range []string{"7", "plugins/netbox-dns/zones", "plugins/netbox-dns/zones/example.com"} {
+		d := schema.TestResourceDataRaw(t, resourceNetboxPluginObject().Schema, map[string]interface{}{})
+		d.SetId(importID)
+		_, err := resourceNetboxPluginObjectImport(context.Background(), d, nil)
+		assert.Error(t, err, importID)
+	}
+}
+
+func TestGetPluginObjectFields(t *testing.T) {
+	ts, schemaRequests := newPluginObjectTestServer(t)
+	defer ts.Close()
+
+	api := testMockProviderState(t, ts.URL)
+	fields, err := getPluginObjectFields(api, "plugins/netbox-dns/zones")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"name", "nameservers", "status", "view"}, fields)
+
+	_, err = getPluginObjectFields(api, "plugins/netbox-dns/records")
+	assert.ErrorContains(t, err, "/api/plugins/netbox-dns/records/ is not part of the OpenAPI schema")
+
+	// the schema is only fetched once
+	assert.Equal(t, 1, *schemaRequests)
+}
+
+func TestNormalizePluginObjectValue(t *testing.T) {
+	nested := map[string]interface{}{"id": float64(1), "name": "_default_"}
+	choice := map[string]interface{}{"value": "active", "label": "Active"}
+
+	assert.Equal(t, float64(1), normalizePluginObjectValue(float64(1), nested))
+	assert.Equal(t, "active", normalizePluginObjectValue("active", choice))
+	assert.Equal(t, []interface{}{float64(1)}, normalizePluginObjectValue([]interface{}{float64(1)}, []interface{}{nested}))
+	// objects in the configuration are compared as objects
+	assert.Equal(t, map[string]interface{}{"name": "_default_"}, normalizePluginObjectValue(map[string]interface{}{"name": "x"}, nested))
+	assert.Equal(t, "example.com", normalizePluginObjectValue("example.org", "example.com"))
+}
@@ -0,0 +1,216 @@
+package netbox
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxSavedFilter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxSavedFilterCreate,
+		Read:   resourceNetboxSavedFilterRead,
+		Update: resourceNetboxSavedFilterUpdate,
+		Delete: resourceNetboxSavedFilterDelete,
+
+		Description: `:meta:subcategory:Extras:From the [official documentation](https://netboxlabs.com/docs/netbox/models/extras/savedfilter/):
+
+> When filtering lists of objects in NetBox, users can save applied filters for future use. This is handy for complex filter strategies involving multiple discrete filters. For example, you might want to find all planned devices within a region that have a specific platform.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"object_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The object types this filter applies to, e.g. `dcim.device`.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"weight": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"shared": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the filter is available to all users.",
+			},
+			"parameters": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					equal, _ := jsonSemanticCompare(oldValue, newValue)
+					return equal
+				},
+				DiffSuppressOnRefresh: true,
+				Description:           "The filter parameters as JSON object, e.g. `{\"status\": [\"active\"]}`.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getSavedFilterFromResourceData(d *schema.ResourceData) (*models.SavedFilter, error) {
+	name := d.Get("name").(string)
+	slugValue, slugOk := d.GetOk("slug")
+	var slug string
+	// Default slug to generated slug if not given
+	if !slugOk {
+		slug = getSlug(name)
+	} else {
+		slug = slugValue.(string)
+	}
+
+	var parameters any
+	err := json.Unmarshal([]byte(d.Get("parameters").(string)), &parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SavedFilter{
+		Name:        &name,
+		Slug:        &slug,
+		Description: d.Get("description").(string),
+		Weight:      int64ToPtr(int64(d.Get("weight").(int))),
+		Enabled:     d.Get("enabled").(bool),
+		Shared:      d.Get("shared").(bool),
+		Parameters:  parameters,
+	}, nil
+}
+
+// getSavedFilterOverrideFields returns the fields that are sent explicitly.
+// The go-netbox model only knows the pre-4.0 `content_types` and omits empty
+// values, which would prevent disabling or unsharing a filter.
+func getSavedFilterOverrideFields(d *schema.ResourceData) map[string]any {
+	return map[string]any{
+		"object_types": toStringList(d.Get("object_types")),
+		"description":  d.Get("description").(string),
+		"enabled":      d.Get("enabled").(bool),
+		"shared":       d.Get("shared").(bool),
+	}
+}
+
+func resourceNetboxSavedFilterCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data, err := getSavedFilterFromResourceData(d)
+	if err != nil {
+		return err
+	}
+
+	params := extras.NewExtrasSavedFiltersCreateParams().WithData(data)
+
+	res, err := api.Extras.ExtrasSavedFiltersCreate(params, nil, hackSerializeWithValues(getSavedFilterOverrideFields(d)))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxSavedFilterRead(d, m)
+}
+
+func resourceNetboxSavedFilterRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := extras.NewExtrasSavedFiltersReadParams().WithID(id)
+
+	var objectTypes rawObjectTypes
+	res, err := api.Extras.ExtrasSavedFiltersRead(params, nil, withRawResponse(&objectTypes))
+	if err != nil {
+		if errresp, ok := err.(*extras.ExtrasSavedFiltersReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	filter := res.GetPayload()
+	d.Set("name", filter.Name)
+	d.Set("slug", filter.Slug)
+	d.Set("object_types", objectTypes.ObjectTypes)
+	d.Set("description", filter.Description)
+	d.Set("weight", filter.Weight)
+	d.Set("enabled", filter.Enabled)
+	d.Set("shared", filter.Shared)
+
+	parameters, err := json.Marshal(filter.Parameters)
+	if err != nil {
+		return err
+	}
+	d.Set("parameters", string(parameters))
+
+	return nil
+}
+
+func resourceNetboxSavedFilterUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := getSavedFilterFromResourceData(d)
+	if err != nil {
+		return err
+	}
+
+	params := extras.NewExtrasSavedFiltersUpdateParams().WithID(id).WithData(data)
+
+	_, err = api.Extras.ExtrasSavedFiltersUpdate(params, nil, hackSerializeWithValues(getSavedFilterOverrideFields(d)))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxSavedFilterRead(d, m)
+}
+
+func resourceNetboxSavedFilterDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := extras.NewExtrasSavedFiltersDeleteParams().WithID(id)
+
+	_, err := api.Extras.ExtrasSavedFiltersDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*extras.ExtrasSavedFiltersDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
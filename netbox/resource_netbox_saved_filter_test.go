@@ -0,0 +1,64 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxSavedFilter_basic(t *testing.T) {
+	testName := testAccGetTestName("saved_filter")
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_saved_filter" "test" {
+  name         = "%[1]s"
+  object_types = ["dcim.device"]
+  description  = "%[1]s description"
+  weight       = 10
+  parameters   = jsonencode({ status = ["planned"], role = ["leaf"] })
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_saved_filter.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_saved_filter.test", "slug", getSlug(testName)),
+					resource.TestCheckResourceAttr("netbox_saved_filter.test", "object_types.#", "1"),
+					resource.TestCheckResourceAttr("netbox_saved_filter.test", "description", testName+" description"),
+					resource.TestCheckResourceAttr("netbox_saved_filter.test", "weight", "10"),
+					resource.TestCheckResourceAttr("netbox_saved_filter.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("netbox_saved_filter.test", "shared", "true"),
+					resource.TestCheckResourceAttr("netbox_saved_filter.test", "parameters", `{"role":["leaf"],"status":["planned"]}`),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_saved_filter" "test" {
+  name         = "%[1]s"
+  slug         = "%[1]s_slug"
+  object_types = ["dcim.device"]
+  enabled      = false
+  shared       = false
+  parameters   = <<-EOT
+    {
+      "status": ["active"]
+    }
+  EOT
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_saved_filter.test", "slug", testName+"_slug"),
+					resource.TestCheckResourceAttr("netbox_saved_filter.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_saved_filter.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("netbox_saved_filter.test", "shared", "false"),
+				),
+			},
+			{
+				ResourceName:      "netbox_saved_filter.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,313 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// jobPollInterval is the interval at which background jobs are polled until they finish.
+var jobPollInterval = 2 * time.Second
+
+var scriptRunPendingStatuses = []string{"pending", "scheduled", "running"}
+var scriptRunFinalStatuses = []string{"completed", "errored", "failed"}
+
+type scriptRunRequest struct {
+	Data   any  `json:"data"`
+	Commit bool `json:"commit"`
+}
+
+type scriptRunResponse struct {
+	Result *rawNestedObject `json:"result"`
+}
+
+type scriptJobLogEntry struct {
+	Time    string  `json:"time"`
+	Status  string  `json:"status"`
+	Message string  `json:"message"`
+	Object  *string `json:"obj"`
+	URL     *string `json:"url"`
+}
+
+type scriptJobData struct {
+	Log    []scriptJobLogEntry `json:"log"`
+	Output string              `json:"output"`
+}
+
+type scriptJob struct {
+	ID        int64      `json:"id"`
+	Status    *rawChoice `json:"status"`
+	Started   *string    `json:"started"`
+	Completed *string    `json:"completed"`
+	Error     string     `json:"error"`
+	// Data is only decoded into scriptJobData once the job has finished
+	Data json.RawMessage `json:"data"`
+}
+
+func resourceNetboxScriptRun() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxScriptRunCreate,
+		ReadContext:   resourceNetboxScriptRunRead,
+		DeleteContext: resourceNetboxScriptRunDelete,
+
+		Description: `:meta:subcategory:Extras:Runs a [custom script](https://netboxlabs.com/docs/netbox/customization/custom-scripts/) and waits for its job to finish.
+
+The script is run once on creation. To run it again, change one of its arguments or a value in ` + "`triggers_replace`" + `. If the job ends in the ` + "`errored`" + ` or ` + "`failed`" + ` status, the apply fails and the resource is tainted, so the script is run again on the next apply. Destroying the resource does not revert the changes made by the script.
+
+Script jobs are executed by the NetBox background worker, which must be running for the job to finish.`,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"script_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the script to run.",
+			},
+			"data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "{}",
+				ValidateFunc: validation.StringIsJSON,
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					equal, _ := jsonSemanticCompare(oldValue, newValue)
+					return equal
+				},
+				Description: "A JSON object with the values of the script variables. Use `jsonencode` to build it.",
+			},
+			"commit": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "If false, the script runs in dry-run mode and its database changes are rolled back.",
+			},
+			"triggers_replace": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Arbitrary values that, when changed, run the script again. Works like `triggers_replace` of the built-in `terraform_data` resource.",
+			},
+			"job_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"started": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"completed": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The output returned by the script.",
+			},
+			"log": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getScriptJob(api *providerState, jobID int64) (*scriptJob, error) {
+	var job scriptJob
+	if err := netboxRawRequest(api, "GET", fmt.Sprintf("/core/jobs/%d/", jobID), nil, nil, &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (job *scriptJob) status() string {
+	if job.Status == nil {
+		return ""
+	}
+	return job.Status.Value
+}
+
+func (job *scriptJob) data() (*scriptJobData, error) {
+	var data scriptJobData
+	if len(job.Data) == 0 || string(job.Data) == "null" {
+		return &data, nil
+	}
+	if err := json.Unmarshal(job.Data, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode data of job %d: %w", job.ID, err)
+	}
+	return &data, nil
+}
+
+// failureMessage summarizes why a job failed, using the job error and the
+// failure messages the script logged.
+func (job *scriptJob) failureMessage(data *scriptJobData) string {
+	var messages []string
+	if job.Error != "" {
+		messages = append(messages, job.Error)
+	}
+	for _, entry := range data.Log {
+		if entry.Status == "failure" || entry.Status == "error" {
+			messages = append(messages, entry.Message)
+		}
+	}
+	if len(messages) == 0 {
+		return "no error details were reported"
+	}
+	return strings.Join(messages, "\n")
+}
+
+func setScriptJobResourceData(d *schema.ResourceData, job *scriptJob, data *scriptJobData) {
+	d.Set("job_id", job.ID)
+	d.Set("status", job.status())
+	d.Set("started", job.Started)
+	d.Set("completed", job.Completed)
+	d.Set("output", data.Output)
+
+	logEntries := make([]map[string]interface{}, 0, len(data.Log))
+	for _, entry := range data.Log {
+		logEntries = append(logEntries, map[string]interface{}{
+			"time":    entry.Time,
+			"status":  entry.Status,
+			"message": entry.Message,
+			"object":  entry.Object,
+			"url":     entry.URL,
+		})
+	}
+	d.Set("log", logEntries)
+}
+
+func resourceNetboxScriptRunCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	scriptID := d.Get("script_id").(int)
+
+	var scriptData any
+	if err := json.Unmarshal([]byte(d.Get("data").(string)), &scriptData); err != nil {
+		return diag.FromErr(err)
+	}
+
+	body := scriptRunRequest{
+		Data:   scriptData,
+		Commit: d.Get("commit").(bool),
+	}
+
+	var res scriptRunResponse
+	if err := netboxRawRequest(api, "POST", fmt.Sprintf("/extras/scripts/%d/", scriptID), nil, body, &res); err != nil {
+		return diag.FromErr(err)
+	}
+	if res.Result == nil {
+		return diag.Errorf("running script %d did not return a job", scriptID)
+	}
+
+	jobID := res.Result.ID
+	d.SetId(strconv.FormatInt(jobID, 10))
+	d.Set("job_id", jobID)
+
+	conf := &retry.StateChangeConf{
+		Pending: scriptRunPendingStatuses,
+		Target:  scriptRunFinalStatuses,
+		Refresh: func() (interface{}, string, error) {
+			job, err := getScriptJob(api, jobID)
+			if err != nil {
+				return nil, "", err
+			}
+			return job, job.status(), nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		PollInterval: jobPollInterval,
+	}
+
+	raw, err := conf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for job %d of script %d: %s", jobID, scriptID, err)
+	}
+
+	job := raw.(*scriptJob)
+	data, err := job.data()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	setScriptJobResourceData(d, job, data)
+
+	if job.status() != "completed" {
+		return diag.Errorf("job %d of script %d finished with status %q: %s", jobID, scriptID, job.status(), job.failureMessage(data))
+	}
+
+	return nil
+}
+
+func resourceNetboxScriptRunRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	job, err := getScriptJob(api, id)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok && errresp.Code() == 404 {
+			// Jobs are removed by NetBox after the configured retention period.
+			// The script run itself still happened, so we keep the last known state
+			// instead of running the script again.
+			log.Printf("[DEBUG] job %d of script run no longer exists, keeping the last known state", id)
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	data, err := job.data()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	setScriptJobResourceData(d, job, data)
+
+	return nil
+}
+
+func resourceNetboxScriptRunDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// A script run cannot be undone, so destroying it only removes it from the state
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,132 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// newScriptRunTestServer returns a mock NetBox that accepts a run of script 7
+// and reports the given job states, one per poll.
+func newScriptRunTestServer(t *testing.T, states []map[string]interface{}) (*httptest.Server, *scriptRunRequest) {
+	var received scriptRunRequest
+	polls := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/extras/scripts/7/":
+			if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+				t.Errorf("failed to decode script run request: %v", err)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":     7,
+				"name":   "BuildRack",
+				"result": map[string]interface{}{"id": 42, "status": map[string]string{"value": "pending", "label": "Pending"}},
+			})
+		case r.Method == "GET" && r.URL.Path == "/api/core/jobs/42/":
+			state := states[min(polls, len(states)-1)]
+			polls++
+			job := map[string]interface{}{"id": 42}
+			for k, v := range state {
+				job[k] = v
+			}
+			json.NewEncoder(w).Encode(job)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	return ts, &received
+}
+
+// testMockProviderState returns a provider state talking to a mock NetBox
+// server. Background jobs are polled without delay.
+func testMockProviderState(t *testing.T, serverURL string) *providerState {
+	pollInterval := jobPollInterval
+	jobPollInterval = 0
+	t.Cleanup(func() { jobPollInterval = pollInterval })
+
+	cfg := Config{APIToken: "test-token", ServerURL: serverURL}
+	client, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return &providerState{NetBoxAPI: client}
+}
+
+func TestResourceNetboxScriptRunCreate_completed(t *testing.T) {
+	ts, received := newScriptRunTestServer(t, []map[string]interface{}{
+		{"status": map[string]string{"value": "running", "label": "Running"}},
+		{
+			"status":    map[string]string{"value": "completed", "label": "Completed"},
+			"started":   "2024-05-01T10:00:00Z",
+			"completed": "2024-05-01T10:00:05Z",
+			"data": map[string]interface{}{
+				"log": []map[string]interface{}{
+					{"time": "2024-05-01T10:00:01Z", "status": "success", "message": "Created rack R1", "obj": "R1", "url": "/dcim/racks/1/"},
+					{"time": "2024-05-01T10:00:02Z", "status": "info", "message": "Done", "obj": nil, "url": nil},
+				},
+				"output": "rack R1 built",
+			},
+		},
+	})
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceNetboxScriptRun().Schema, map[string]interface{}{
+		"script_id": 7,
+		"data":      `{"rack_name": "R1"}`,
+		"commit":    false,
+	})
+
+	diags := resourceNetboxScriptRunCreate(context.Background(), d, testMockProviderState(t, ts.URL))
+	if diags.HasError() {
+		t.Fatalf("resourceNetboxScriptRunCreate returned error: %v", diags)
+	}
+
+	assert.Equal(t, map[string]interface{}{"rack_name": "R1"}, received.Data)
+	assert.False(t, received.Commit)
+
+	assert.Equal(t, "42", d.Id())
+	assert.Equal(t, "completed", d.Get("status"))
+	assert.Equal(t, "rack R1 built", d.Get("output"))
+	assert.Equal(t, "2024-05-01T10:00:05Z", d.Get("completed"))
+	assert.Equal(t, 2, d.Get("log.#"))
+	assert.Equal(t, "Created rack R1", d.Get("log.0.message"))
+	assert.Equal(t, "R1", d.Get("log.0.object"))
+	assert.Equal(t, "", d.Get("log.1.object"))
+}
+
+func TestResourceNetboxScriptRunCreate_failed(t *testing.T) {
+	ts, _ := newScriptRunTestServer(t, []map[string]interface{}{
+		{
+			"status": map[string]string{"value": "failed", "label": "Failed"},
+			"data": map[string]interface{}{
+				"log": []map[string]interface{}{
+					{"time": "2024-05-01T10:00:01Z", "status": "failure", "message": "Site S1 does not exist"},
+				},
+				"output": "",
+			},
+		},
+	})
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceNetboxScriptRun().Schema, map[string]interface{}{
+		"script_id": 7,
+	})
+
+	diags := resourceNetboxScriptRunCreate(context.Background(), d, testMockProviderState(t, ts.URL))
+	if assert.True(t, diags.HasError(), "expected a failed job to fail the apply") {
+		assert.True(t, strings.Contains(diags[0].Summary, "Site S1 does not exist"), "unexpected error: %s", diags[0].Summary)
+	}
+
+	// The ID is kept, so Terraform taints the resource and runs the script again
+	assert.Equal(t, "42", d.Id())
+	assert.Equal(t, "failed", d.Get("status"))
+}
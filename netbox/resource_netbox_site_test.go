@@ -67,6 +67,45 @@ resource "netbox_site" "test" {
 	})
 }
 
+func TestAccNetboxSite_lastUpdated(t *testing.T) {
+	testSlug := "site_last_updated"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "netbox" {
+  conflict_detection = "error"
+}
+
+resource "netbox_site" "test" {
+  name = "%s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netbox_site.test", "last_updated"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "netbox" {
+  conflict_detection = "error"
+}
+
+resource "netbox_site" "test" {
+  name        = "%s"
+  description = "updated"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_site.test", "description", "updated"),
+					resource.TestCheckResourceAttrSet("netbox_site.test", "last_updated"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetboxSite_customFields(t *testing.T) {
 	testSlug := "site_detail"
 	testName := testAccGetTestName(testSlug)
@@ -0,0 +1,125 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type subscription struct {
+	ID         int64            `json:"id,omitempty"`
+	ObjectType string           `json:"object_type"`
+	ObjectID   int64            `json:"object_id"`
+	User       *rawNestedObject `json:"user"`
+	Created    string           `json:"created"`
+}
+
+type writableSubscription struct {
+	ObjectType string `json:"object_type"`
+	ObjectID   int64  `json:"object_id"`
+	User       int64  `json:"user"`
+}
+
+func resourceNetboxSubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxSubscriptionCreate,
+		Read:   resourceNetboxSubscriptionRead,
+		Delete: resourceNetboxSubscriptionDelete,
+
+		Description: `:meta:subcategory:Extras:From the [official documentation](https://netboxlabs.com/docs/netbox/models/extras/subscription/):
+
+> A record indicating that a user is to be notified of any changes to a particular NetBox object.
+
+Requires NetBox 4.1 or later.`,
+
+		Schema: map[string]*schema.Schema{
+			"object_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The type of the subscribed object, e.g. `dcim.device`.",
+			},
+			"object_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxSubscriptionCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := &writableSubscription{
+		ObjectType: d.Get("object_type").(string),
+		ObjectID:   int64(d.Get("object_id").(int)),
+		User:       int64(d.Get("user_id").(int)),
+	}
+
+	var res subscription
+	err := netboxRawRequest(api, "POST", "/extras/subscriptions/", nil, data, &res)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxSubscriptionRead(d, m)
+}
+
+func resourceNetboxSubscriptionRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	var sub subscription
+	err := netboxRawRequest(api, "GET", fmt.Sprintf("/extras/subscriptions/%s/", d.Id()), nil, nil, &sub)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("object_type", sub.ObjectType)
+	d.Set("object_id", sub.ObjectID)
+	d.Set("created", sub.Created)
+	if sub.User != nil {
+		d.Set("user_id", sub.User.ID)
+	}
+
+	return nil
+}
+
+func resourceNetboxSubscriptionDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	err := netboxRawRequest(api, "DELETE", fmt.Sprintf("/extras/subscriptions/%s/", d.Id()), nil, nil, nil)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxSubscription_basic(t *testing.T) {
+	testName := testAccGetTestName("subscription")
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_user" "test" {
+  username = "%[1]s"
+  password = "Abcdefghijkl1"
+}
+
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_subscription" "test" {
+  object_type = "dcim.site"
+  object_id   = netbox_site.test.id
+  user_id     = netbox_user.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_subscription.test", "object_type", "dcim.site"),
+					resource.TestCheckResourceAttrPair("netbox_subscription.test", "object_id", "netbox_site.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_subscription.test", "user_id", "netbox_user.test", "id"),
+					resource.TestCheckResourceAttrSet("netbox_subscription.test", "created"),
+				),
+			},
+			{
+				ResourceName:      "netbox_subscription.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
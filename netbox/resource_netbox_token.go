@@ -2,11 +2,14 @@ package netbox
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/fbreckle/go-netbox/netbox/client/users"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,18 +24,67 @@ func resourceNetboxToken() *schema.Resource {
 
 		Description: `:meta:subcategory:Authentication:From the [official documentation](https://docs.netbox.dev/en/stable/rest-api/authentication/#tokens):
 
-> A token is a unique identifier mapped to a NetBox user account. Each user may have one or more tokens which he or she can use for authentication when making REST API requests. To create a token, navigate to the API tokens page under your user profile.`,
+> A token is a unique identifier mapped to a NetBox user account. Each user may have one or more tokens which he or she can use for authentication when making REST API requests. To create a token, navigate to the API tokens page under your user profile.
+
+Since NetBox 4.5, v2 tokens can be provisioned with ` + "`version = 2`" + `. If NetBox generates the secret, it is only returned once and stored in the sensitive ` + "`token`" + ` attribute. To keep a secret out of the state, set it with the write-only ` + "`key_wo`" + ` attribute instead (requires Terraform 1.11 or later).`,
 
 		Schema: map[string]*schema.Schema{
 			"user_id": {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 2}),
+				Description:  "The token version. v2 tokens require NetBox 4.5 or later. Defaults to the default of NetBox.",
+			},
 			"key": {
-				Type:         schema.TypeString,
-				Sensitive:    true,
+				Type:          schema.TypeString,
+				Sensitive:     true,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.StringLenBetween(40, 256),
+				ConflictsWith: []string{"key_wo"},
+				Description:   "The key of a v1 token. For v2 tokens, this is the public identifier generated by NetBox.",
+			},
+			"key_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ValidateFunc:  validation.StringLenBetween(40, 256),
+				ConflictsWith: []string{"key"},
+				RequiredWith:  []string{"key_wo_version"},
+				Description:   "The key of a v1 token or the secret of a v2 token, which is not stored in the state.",
+			},
+			"key_wo_version": {
+				Type:         schema.TypeInt,
 				Optional:     true,
-				ValidateFunc: validation.StringLenBetween(40, 256),
+				RequiredWith: []string{"key_wo"},
+				Description:  "Change this value to update the token to the current `key_wo`. v2 tokens are replaced, as their secret cannot be changed.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Computed:    true,
+				Description: "The plaintext token to authenticate with, if NetBox generated it. It is only returned when the token is created. For v2 tokens, this is the complete `nbt_<key>.<secret>` value.",
+			},
+			"rotation_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Replace the token once it is older than the given number of days, or shortly before it expires, whichever comes first. The token is replaced during the next apply after that point in time. If `expires` is set, the expiry date of each new token is the configured date moved forward by whole rotation periods until it is more than a day ahead, so replacements never inherit an expiry date that has passed.",
+			},
+			"rotate_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The point in time after which the token is replaced, if `rotation_days` is set.",
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"allowed_ips": {
 				Type:     schema.TypeList,
@@ -51,21 +103,157 @@ func resourceNetboxToken() *schema.Resource {
 				Computed: true,
 			},
 			"expires": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.IsRFC3339Time,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressTokenExpiresRotation,
 			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
 		},
+		CustomizeDiff: resourceNetboxTokenCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
+// tokenSecret captures the attributes of a token that are missing from the
+// go-netbox model, including the one-time secret of a newly created token.
+type tokenSecret struct {
+	Version int64  `json:"version"`
+	Key     string `json:"key"`
+	Token   string `json:"token"`
+}
+
+// getTokenWriteOnlyKey returns the write-only key from the configuration.
+// Write-only values are never persisted, so they are only available while applying.
+func getTokenWriteOnlyKey(d *schema.ResourceData) string {
+	keyWO, diags := d.GetRawConfigAt(cty.GetAttrPath("key_wo"))
+	if diags.HasError() || !keyWO.Type().Equals(cty.String) || keyWO.IsNull() || !keyWO.IsKnown() {
+		return ""
+	}
+	return keyWO.AsString()
+}
+
+// tokenRotationMargin is how long before its expiry a token with
+// `rotation_days` is replaced at the latest.
+const tokenRotationMargin = 24 * time.Hour
+
+// getTokenRotationMargin returns the rotation margin, which is at most half of
+// the rotation period.
+func getTokenRotationMargin(rotationDays int) time.Duration {
+	return min(tokenRotationMargin, time.Duration(rotationDays)*12*time.Hour)
+}
+
+// getTokenRotateAfter returns the point in time after which a token created
+// at the given time is replaced: once the rotation period has passed, or the
+// rotation margin before the token expires.
+func getTokenRotateAfter(created time.Time, rotationDays int, expires string) time.Time {
+	rotateAfter := created.AddDate(0, 0, rotationDays)
+	if expiresAt, err := time.Parse(time.RFC3339, expires); err == nil {
+		if beforeExpiry := expiresAt.Add(-getTokenRotationMargin(rotationDays)); beforeExpiry.Before(rotateAfter) {
+			return beforeExpiry
+		}
+	}
+	return rotateAfter
+}
+
+// getTokenExpires returns the expiry date to send for a token. With
+// `rotation_days`, the configured date is moved forward by whole rotation
+// periods until it is past the rotation margin, so that replacements do not
+// inherit an expiry date that has passed.
+func getTokenExpires(d *schema.ResourceData, now time.Time) (*strfmt.DateTime, error) {
+	expiresRaw, ok := d.GetOk("expires")
+	if !ok {
+		return nil, nil
+	}
+	expires, err := time.Parse(time.RFC3339, expiresRaw.(string))
+	if err != nil {
+		return nil, err
+	}
+
+	if rotationDays := d.Get("rotation_days").(int); rotationDays > 0 {
+		for !expires.After(now.Add(getTokenRotationMargin(rotationDays))) {
+			expires = expires.AddDate(0, 0, rotationDays)
+		}
+	}
+
+	dt := strfmt.DateTime(expires)
+	return &dt, nil
+}
+
+// suppressTokenExpiresRotation hides the difference between the configured
+// expiry date and the one of the current token if the latter was moved
+// forward by whole rotation periods, see getTokenExpires.
+func suppressTokenExpiresRotation(k, old, new string, d *schema.ResourceData) bool {
+	rotationDays := d.Get("rotation_days").(int)
+	oldExpires, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	expires, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	for rotationDays > 0 && expires.Before(oldExpires) {
+		expires = expires.AddDate(0, 0, rotationDays)
+	}
+	return expires.Equal(oldExpires)
+}
+
+func resourceNetboxTokenCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("version").(int) == 2 {
+		if err := m.(*providerState).requireCapability("v2 tokens", netboxCapability{minVersion: "4.5"}); err != nil {
+			return err
+		}
+	}
+
+	if rawConfig := d.GetRawConfig(); d.Get("version").(int) == 2 && rawConfig.IsKnown() && !rawConfig.IsNull() && !rawConfig.GetAttr("key").IsNull() {
+		return fmt.Errorf("key cannot be set for v2 tokens, use key_wo to set the secret")
+	}
+
+	// New tokens get their rotation date once they are created
+	if d.Id() == "" {
+		return nil
+	}
+
+	// The secret of a v2 token cannot be changed, so a new one is created instead
+	if d.Get("version").(int) == 2 && d.HasChange("key_wo_version") {
+		if err := d.ForceNew("key_wo_version"); err != nil {
+			return err
+		}
+	}
+
+	rotationDays := d.Get("rotation_days").(int)
+	if rotationDays == 0 {
+		if d.Get("rotate_after").(string) != "" {
+			return d.SetNew("rotate_after", "")
+		}
+		return nil
+	}
+
+	created, err := time.Parse(time.RFC3339, d.Get("created").(string))
+	if err != nil {
+		return nil
+	}
+
+	rotateAfter := getTokenRotateAfter(created, rotationDays, d.Get("expires").(string))
+	if time.Now().Before(rotateAfter) {
+		if rotateAfterString := rotateAfter.UTC().Format(time.RFC3339); d.Get("rotate_after").(string) != rotateAfterString {
+			return d.SetNew("rotate_after", rotateAfterString)
+		}
+		return nil
+	}
+
+	if err := d.SetNewComputed("rotate_after"); err != nil {
+		return err
+	}
+	return d.ForceNew("rotate_after")
+}
+
 func resourceNetboxTokenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	data := models.WritableToken{}
@@ -73,10 +261,26 @@ func resourceNetboxTokenCreate(ctx context.Context, d *schema.ResourceData, m in
 	userid := int64(d.Get("user_id").(int))
 
 	key := d.Get("key").(string)
+	keyWO := getTokenWriteOnlyKey(d)
 	allowedIps := d.Get("allowed_ips").([]interface{})
 
 	data.User = &userid
-	data.Key = key
+
+	// v2 tokens take their secret as `token`, the key is generated by NetBox
+	overrideFields := make(map[string]any)
+	version, versionOk := d.GetOk("version")
+	if versionOk {
+		overrideFields["version"] = version.(int)
+	}
+	if versionOk && version.(int) == 2 {
+		if keyWO != "" {
+			overrideFields["token"] = keyWO
+		}
+	} else if keyWO != "" {
+		data.Key = keyWO
+	} else {
+		data.Key = key
+	}
 
 	data.AllowedIps = make([]models.IPNetwork, len(allowedIps))
 	for i, v := range allowedIps {
@@ -86,20 +290,32 @@ func resourceNetboxTokenCreate(ctx context.Context, d *schema.ResourceData, m in
 	data.WriteEnabled = d.Get("write_enabled").(bool)
 	data.Description = d.Get("description").(string)
 
-	if expiresRaw, ok := d.GetOk("expires"); ok {
-		expires, err := strfmt.ParseDateTime(expiresRaw.(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		data.Expires = &expires
+	expires, err := getTokenExpires(d, time.Now())
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	data.Expires = expires
 
 	params := users.NewUsersTokensCreateParams().WithData(&data)
-	res, err := api.Users.UsersTokensCreate(params, nil)
+	var secret tokenSecret
+	res, err := api.Users.UsersTokensCreate(params, nil, hackSerializeWithValues(overrideFields), withRawResponse(&secret))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+	if secret.Version != 0 {
+		d.Set("version", secret.Version)
+	}
+
+	// The secret is only returned once. Keep it, unless it was set by the user
+	if key == "" && keyWO == "" {
+		switch {
+		case secret.Version == 2 && secret.Token != "":
+			d.Set("token", fmt.Sprintf("nbt_%s.%s", secret.Key, secret.Token))
+		case secret.Version != 2 && secret.Key != "":
+			d.Set("token", secret.Key)
+		}
+	}
 
 	return resourceNetboxTokenUpdate(ctx, d, m)
 }
@@ -109,7 +325,8 @@ func resourceNetboxTokenRead(ctx context.Context, d *schema.ResourceData, m inte
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := users.NewUsersTokensReadParams().WithID(id)
 
-	res, err := api.Users.UsersTokensRead(params, nil)
+	var secret tokenSecret
+	res, err := api.Users.UsersTokensRead(params, nil, withRawResponse(&secret))
 	if err != nil {
 		if errresp, ok := err.(*users.UsersTokensReadDefault); ok {
 			errorcode := errresp.Code()
@@ -127,11 +344,31 @@ func resourceNetboxTokenRead(ctx context.Context, d *schema.ResourceData, m inte
 		d.Set("user_id", token.User.ID)
 	}
 
+	// NetBox 4.5 and later report the version, earlier versions only know v1 tokens
+	if secret.Version != 0 {
+		d.Set("version", secret.Version)
+	} else {
+		d.Set("version", 1)
+	}
+
 	// Since NetBox 4.3.0, ALLOW_TOKEN_RETRIEVAL is disabled by default
 	// This means we will usually not get a Key value from the API
-	if token.Key != "" {
+	// A write-only key must not end up in the state either
+	if _, writeOnly := d.GetOk("key_wo_version"); token.Key != "" && (!writeOnly || secret.Version == 2) {
 		d.Set("key", token.Key)
 	}
+
+	d.Set("created", token.Created.String())
+	if rotationDays, ok := d.GetOk("rotation_days"); ok {
+		var expires string
+		if token.Expires != nil {
+			expires = token.Expires.String()
+		}
+		rotateAfter := getTokenRotateAfter(time.Time(token.Created), rotationDays.(int), expires)
+		d.Set("rotate_after", rotateAfter.UTC().Format(time.RFC3339))
+	} else {
+		d.Set("rotate_after", "")
+	}
 	d.Set("last_used", token.LastUsed)
 	if token.Expires != nil {
 		d.Set("expires", token.Expires.String())
@@ -149,11 +386,20 @@ func resourceNetboxTokenUpdate(ctx context.Context, d *schema.ResourceData, m in
 	data := models.WritableToken{}
 
 	userid := int64(d.Get("user_id").(int))
-	key := d.Get("key").(string)
 	allowedIps := d.Get("allowed_ips").([]interface{})
 
 	data.User = &userid
-	data.Key = key
+
+	// The key of a v2 token is generated by NetBox and its secret cannot be changed
+	if d.Get("version").(int) != 2 {
+		if _, writeOnly := d.GetOk("key_wo_version"); writeOnly {
+			if d.HasChange("key_wo_version") {
+				data.Key = getTokenWriteOnlyKey(d)
+			}
+		} else {
+			data.Key = d.Get("key").(string)
+		}
+	}
 
 	data.AllowedIps = make([]models.IPNetwork, len(allowedIps))
 	for i, v := range allowedIps {
@@ -163,16 +409,14 @@ func resourceNetboxTokenUpdate(ctx context.Context, d *schema.ResourceData, m in
 	data.WriteEnabled = d.Get("write_enabled").(bool)
 	data.Description = d.Get("description").(string)
 
-	if expiresRaw, ok := d.GetOk("expires"); ok {
-		expires, err := strfmt.ParseDateTime(expiresRaw.(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		data.Expires = &expires
+	expires, err := getTokenExpires(d, time.Now())
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	data.Expires = expires
 
 	params := users.NewUsersTokensUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Users.UsersTokensUpdate(params, nil)
+	_, err = api.Users.UsersTokensUpdate(params, nil)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -1,14 +1,22 @@
 package netbox
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/fbreckle/go-netbox/netbox/client/users"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccNetboxToken_basic(t *testing.T) {
@@ -101,6 +109,198 @@ resource "netbox_token" "test_without_expires" {
 	})
 }
 
+func TestAccNetboxToken_rotation(t *testing.T) {
+	if testAccNetboxVersionAtLeast("4.5.0") {
+		t.Skipf("Skipping token test on NetBox %s: token creation requires API_TOKEN_PEPPERS which is not configured in the test environment", os.Getenv("NETBOX_VERSION"))
+	}
+	testSlug := "users"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_user" "test" {
+  username = "%s"
+  password = "Abcdefghijkl1"
+}
+
+resource "netbox_token" "test_rotation" {
+  user_id       = netbox_user.test.id
+  rotation_days = 30
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_token.test_rotation", "version", "1"),
+					resource.TestCheckResourceAttrSet("netbox_token.test_rotation", "token"),
+					resource.TestCheckResourceAttrSet("netbox_token.test_rotation", "created"),
+					resource.TestCheckResourceAttrSet("netbox_token.test_rotation", "rotate_after"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceNetboxTokenCreate_v2(t *testing.T) {
+	var received map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		token := map[string]interface{}{
+			"id":            5,
+			"version":       2,
+			"key":           "Nq9RrAxE3b5k",
+			"user":          map[string]interface{}{"id": 1, "username": "admin"},
+			"created":       "2026-01-10T08:00:00Z",
+			"write_enabled": true,
+			"allowed_ips":   []string{},
+		}
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/users/tokens/":
+			if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+				t.Errorf("failed to decode token request: %v", err)
+			}
+			token["token"] = "4pBmTcKzW0eR8yXdNh2sLqJ6vF1uGi7oAaYe3Hc9"
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(token)
+		case r.Method == "PUT" && r.URL.Path == "/api/users/tokens/5/":
+			json.NewEncoder(w).Encode(token)
+		case r.Method == "GET" && r.URL.Path == "/api/users/tokens/5/":
+			json.NewEncoder(w).Encode(token)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceNetboxToken().Schema, map[string]interface{}{
+		"user_id":       1,
+		"version":       2,
+		"write_enabled": true,
+		"rotation_days": 90,
+	})
+
+	diags := resourceNetboxTokenCreate(context.Background(), d, testMockProviderState(t, ts.URL))
+	if diags.HasError() {
+		t.Fatalf("resourceNetboxTokenCreate returned error: %v", diags)
+	}
+
+	assert.Equal(t, float64(2), received["version"])
+	assert.NotContains(t, received, "key")
+	assert.NotContains(t, received, "token")
+
+	assert.Equal(t, "5", d.Id())
+	assert.Equal(t, 2, d.Get("version"))
+	assert.Equal(t, "Nq9RrAxE3b5k", d.Get("key"))
+	assert.Equal(t, "nbt_Nq9RrAxE3b5k.4pBmTcKzW0eR8yXdNh2sLqJ6vF1uGi7oAaYe3Hc9", d.Get("token"))
+	assert.Equal(t, "2026-04-10T08:00:00Z", d.Get("rotate_after"))
+}
+
+func TestGetTokenRotateAfter(t *testing.T) {
+	created := time.Date(2026, 1, 10, 8, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2026, 2, 9, 8, 0, 0, 0, time.UTC), getTokenRotateAfter(created, 30, ""))
+	// Tokens are replaced a day before they expire
+	assert.Equal(t, time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC), getTokenRotateAfter(created, 30, "2026-02-01T00:00:00Z"))
+	assert.Equal(t, time.Date(2026, 2, 9, 8, 0, 0, 0, time.UTC), getTokenRotateAfter(created, 30, "2027-01-01T00:00:00Z"))
+	// The margin is at most half of the rotation period
+	assert.Equal(t, time.Date(2026, 1, 10, 20, 0, 0, 0, time.UTC), getTokenRotateAfter(created, 1, "2026-01-11T08:00:00Z"))
+}
+
+func TestGetTokenExpires(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		expires      string
+		rotationDays int
+		expected     string
+	}{
+		{"", 30, ""},
+		{"2027-01-01T00:00:00Z", 30, "2027-01-01T00:00:00.000Z"},
+		// Without rotation, the configured date is sent as is
+		{"2026-01-01T00:00:00Z", 0, "2026-01-01T00:00:00.000Z"},
+		// An expiry date in the past is moved forward by whole rotation periods
+		{"2026-01-05T00:00:00Z", 30, "2026-03-06T00:00:00.000Z"},
+		// ... until it is past the rotation margin
+		{"2026-02-01T00:00:00Z", 29, "2026-03-31T00:00:00.000Z"},
+	} {
+		d := schema.TestResourceDataRaw(t, resourceNetboxToken().Schema, map[string]interface{}{
+			"user_id":       1,
+			"expires":       tt.expires,
+			"rotation_days": tt.rotationDays,
+		})
+
+		expires, err := getTokenExpires(d, now)
+		assert.NoError(t, err)
+		if tt.expected == "" {
+			assert.Nil(t, expires)
+		} else if assert.NotNil(t, expires) {
+			assert.Equal(t, tt.expected, expires.String(), tt.expires)
+		}
+	}
+}
+
+func TestSuppressTokenExpiresRotation(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNetboxToken().Schema, map[string]interface{}{
+		"user_id":       1,
+		"rotation_days": 30,
+	})
+
+	// The expiry date of a rotated token is ahead of the configured date by
+	// whole rotation periods
+	assert.True(t, suppressTokenExpiresRotation("expires", "2026-03-02T00:00:00.000Z", "2026-01-01T00:00:00Z", d))
+	assert.True(t, suppressTokenExpiresRotation("expires", "2026-01-01T00:00:00.000Z", "2026-01-01T00:00:00Z", d))
+	assert.False(t, suppressTokenExpiresRotation("expires", "2026-03-03T00:00:00.000Z", "2026-01-01T00:00:00Z", d))
+	assert.False(t, suppressTokenExpiresRotation("expires", "2026-01-01T00:00:00.000Z", "2026-03-02T00:00:00Z", d))
+	assert.False(t, suppressTokenExpiresRotation("expires", "2026-01-01T00:00:00.000Z", "", d))
+}
+
+func testTokenRotationDiff(t *testing.T, created time.Time, expires string) *terraform.InstanceDiff {
+	state := &terraform.InstanceState{
+		ID: "5",
+		Attributes: map[string]string{
+			"id":            "5",
+			"user_id":       "1",
+			"version":       "1",
+			"rotation_days": "30",
+			"created":       created.UTC().Format(time.RFC3339),
+			"rotate_after":  getTokenRotateAfter(created, 30, expires).UTC().Format(time.RFC3339),
+			"expires":       expires,
+		},
+	}
+	config := map[string]interface{}{
+		"user_id":       1,
+		"rotation_days": 30,
+	}
+	if expires != "" {
+		config["expires"] = expires
+	}
+
+	meta := &providerState{defaultTags: schema.NewSet(schema.HashString, nil)}
+	diff, err := Provider().ResourcesMap["netbox_token"].Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), meta)
+	if err != nil {
+		t.Fatalf("Diff returned error: %v", err)
+	}
+	return diff
+}
+
+func TestResourceNetboxTokenCustomizeDiff_rotation(t *testing.T) {
+	now := time.Now()
+
+	// Rotation is due after the rotation period
+	assert.True(t, testTokenRotationDiff(t, now.AddDate(0, 0, -40), "").RequiresNew())
+	assert.True(t, testTokenRotationDiff(t, now.AddDate(0, 0, -40), now.AddDate(1, 0, 0).UTC().Format(time.RFC3339)).RequiresNew())
+	assert.Nil(t, testTokenRotationDiff(t, now.AddDate(0, 0, -10), now.AddDate(1, 0, 0).UTC().Format(time.RFC3339)))
+
+	// ... or shortly before the token expires
+	assert.True(t, testTokenRotationDiff(t, now.AddDate(0, 0, -10), now.Add(time.Hour).UTC().Format(time.RFC3339)).RequiresNew())
+}
+
+func TestResourceNetboxTokenCustomizeDiff_rotationExpired(t *testing.T) {
+	// An expired token is replaced, the replacement gets an expiry date in the
+	// future, see TestGetTokenExpires
+	assert.True(t, testTokenRotationDiff(t, time.Now().AddDate(0, 0, -10), time.Now().AddDate(0, 0, -1).UTC().Format(time.RFC3339)).RequiresNew())
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_token", &resource.Sweeper{
 		Name:         "netbox_token",
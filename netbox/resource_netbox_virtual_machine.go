@@ -94,11 +94,20 @@ func resourceNetboxVirtualMachine() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"config_template_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
 			"local_context_data": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "This is best managed through the use of `jsonencode` and a map of settings.",
 			},
+			"config_context": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The config context data of this virtual machine as JSON, merged from all applicable config contexts and the local context data.",
+			},
 			customFieldsKey: customFieldsSchema,
 		},
 		Importer: &schema.ResourceImporter{
@@ -120,6 +129,18 @@ func resourceNetboxVirtualMachine() *schema.Resource {
 	}
 }
 
+// virtualMachineConfigTemplate captures the config template of a virtual
+// machine, which is missing from the go-netbox model.
+type virtualMachineConfigTemplate struct {
+	ConfigTemplate *rawNestedObject `json:"config_template"`
+}
+
+func getVirtualMachineConfigTemplateFields(d *schema.ResourceData) map[string]any {
+	return map[string]any{
+		"config_template": getOptionalInt(d, "config_template_id"),
+	}
+}
+
 func resourceNetboxVirtualMachineCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
@@ -210,7 +231,7 @@ func resourceNetboxVirtualMachineCreate(ctx context.Context, d *schema.ResourceD
 
 	params := virtualization.NewVirtualizationVirtualMachinesCreateParams().WithData(&data)
 
-	res, err := api.Virtualization.VirtualizationVirtualMachinesCreate(params, nil)
+	res, err := api.Virtualization.VirtualizationVirtualMachinesCreate(params, nil, hackSerializeWithValues(getVirtualMachineConfigTemplateFields(d)))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -229,7 +250,8 @@ func resourceNetboxVirtualMachineRead(ctx context.Context, d *schema.ResourceDat
 
 	params := virtualization.NewVirtualizationVirtualMachinesReadParams().WithID(id)
 
-	res, err := api.Virtualization.VirtualizationVirtualMachinesRead(params, nil)
+	var configTemplate virtualMachineConfigTemplate
+	res, err := api.Virtualization.VirtualizationVirtualMachinesRead(params, nil, withRawResponse(&configTemplate))
 	if err != nil {
 		if errresp, ok := err.(*virtualization.VirtualizationVirtualMachinesReadDefault); ok {
 			errorcode := errresp.Code()
@@ -308,6 +330,20 @@ func resourceNetboxVirtualMachineRead(ctx context.Context, d *schema.ResourceDat
 		d.Set("local_context_data", nil)
 	}
 
+	if configTemplate.ConfigTemplate != nil {
+		d.Set("config_template_id", configTemplate.ConfigTemplate.ID)
+	} else {
+		d.Set("config_template_id", nil)
+	}
+
+	if vm.ConfigContext != nil {
+		if jsonArr, err := json.Marshal(vm.ConfigContext); err == nil {
+			d.Set("config_context", string(jsonArr))
+		}
+	} else {
+		d.Set("config_context", nil)
+	}
+
 	d.Set("comments", vm.Comments)
 	d.Set("description", vm.Description)
 	vcpus := vm.Vcpus
@@ -455,7 +491,7 @@ func resourceNetboxVirtualMachineUpdate(ctx context.Context, d *schema.ResourceD
 
 	params := virtualization.NewVirtualizationVirtualMachinesUpdateParams().WithID(id).WithData(&data)
 
-	_, err = api.Virtualization.VirtualizationVirtualMachinesUpdate(params, nil)
+	_, err = api.Virtualization.VirtualizationVirtualMachinesUpdate(params, nil, hackSerializeWithValues(getVirtualMachineConfigTemplateFields(d)))
 	if err != nil {
 		return diag.FromErr(err)
 	}
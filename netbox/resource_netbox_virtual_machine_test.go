@@ -122,6 +122,56 @@ resource "netbox_virtual_machine" "only_site" {
 	})
 }
 
+func TestAccNetboxVirtualMachine_configTemplate(t *testing.T) {
+	testSlug := "vm_cfg_tmpl"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxVirtualMachineSiteClusterDependencies(testName) + fmt.Sprintf(`
+resource "netbox_config_template" "test" {
+  name          = "%[1]s"
+  template_code = "hostname={{ virtualmachine.name }}"
+}
+
+resource "netbox_config_context" "test" {
+  name  = "%[1]s"
+  data  = jsonencode({ ntp_server = "192.0.2.1" })
+  sites = [netbox_site.test.id]
+}
+
+resource "netbox_virtual_machine" "test" {
+  name               = "%[1]s"
+  site_id            = netbox_site.test.id
+  config_template_id = netbox_config_template.test.id
+  local_context_data = jsonencode({ syslog_server = "192.0.2.2" })
+  depends_on         = [netbox_config_context.test]
+}
+`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_virtual_machine.test", "config_template_id", "netbox_config_template.test", "id"),
+					resource.TestCheckResourceAttrWith("netbox_virtual_machine.test", "config_context", func(value string) error {
+						for _, expected := range []string{`"ntp_server":"192.0.2.1"`, `"syslog_server":"192.0.2.2"`} {
+							if !strings.Contains(value, expected) {
+								return fmt.Errorf("expected config_context %s to contain %s", value, expected)
+							}
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:      "netbox_virtual_machine.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccNetboxVirtualMachine_ClusterWithoutSite(t *testing.T) {
 	testSlug := "vm_clstrnosite"
 	testName := testAccGetTestName(testSlug)
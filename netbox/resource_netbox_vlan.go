@@ -10,6 +10,13 @@ import (
 )
 
 var resourceNetboxVlanStatusOptions = []string{"active", "reserved", "deprecated"}
+var resourceNetboxVlanQinQRoleOptions = []string{"svlan", "cvlan"}
+
+// vlanQinQFields holds the Q-in-Q attributes that the go-netbox VLAN model does not know about
+type vlanQinQFields struct {
+	QinqRole  *rawChoice       `json:"qinq_role"`
+	QinqSvlan *rawNestedObject `json:"qinq_svlan"`
+}
 
 func resourceNetboxVlan() *schema.Resource {
 	return &schema.Resource{
@@ -61,6 +68,17 @@ func resourceNetboxVlan() *schema.Resource {
 				Optional: true,
 				Default:  "",
 			},
+			"qinq_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxVlanQinQRoleOptions, false),
+				Description:  "The role of this VLAN in a Q-in-Q (IEEE 802.1ad) setup. " + buildValidValueDescription(resourceNetboxVlanQinQRoleOptions),
+			},
+			"qinq_svlan_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the service VLAN (SVLAN) this customer VLAN belongs to. Only valid when `qinq_role` is `cvlan`.",
+			},
 			customFieldsKey: customFieldsSchema,
 			tagsKey:         tagsSchema,
 		},
@@ -110,7 +128,7 @@ func resourceNetboxVlanCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	params := ipam.NewIpamVlansCreateParams().WithData(&data)
-	res, err := api.Ipam.IpamVlansCreate(params, nil)
+	res, err := api.Ipam.IpamVlansCreate(params, nil, hackSerializeWithValues(getVlanQinQFields(d)))
 	if err != nil {
 		return err
 	}
@@ -124,7 +142,8 @@ func resourceNetboxVlanRead(d *schema.ResourceData, m interface{}) error {
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamVlansReadParams().WithID(id)
 
-	res, err := api.Ipam.IpamVlansRead(params, nil)
+	var qinq vlanQinQFields
+	res, err := api.Ipam.IpamVlansRead(params, nil, withRawResponse(&qinq))
 	if err != nil {
 		if errresp, ok := err.(*ipam.IpamVlansReadDefault); ok {
 			errorcode := errresp.Code()
@@ -163,6 +182,16 @@ func resourceNetboxVlanRead(d *schema.ResourceData, m interface{}) error {
 	if vlan.Role != nil {
 		d.Set("role_id", vlan.Role.ID)
 	}
+	if qinq.QinqRole != nil {
+		d.Set("qinq_role", qinq.QinqRole.Value)
+	} else {
+		d.Set("qinq_role", nil)
+	}
+	if qinq.QinqSvlan != nil {
+		d.Set("qinq_svlan_id", qinq.QinqSvlan.ID)
+	} else {
+		d.Set("qinq_svlan_id", nil)
+	}
 
 	return nil
 }
@@ -207,7 +236,7 @@ func resourceNetboxVlanUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	params := ipam.NewIpamVlansUpdateParams().WithID(id).WithData(&data)
-	_, err = api.Ipam.IpamVlansUpdate(params, nil)
+	_, err = api.Ipam.IpamVlansUpdate(params, nil, hackSerializeWithValues(getVlanQinQFields(d)))
 	if err != nil {
 		return err
 	}
@@ -231,3 +260,19 @@ func resourceNetboxVlanDelete(d *schema.ResourceData, m interface{}) error {
 
 	return nil
 }
+
+// getVlanQinQFields returns the Q-in-Q attributes for the request body,
+// explicitly nulling unset attributes so they can be removed.
+func getVlanQinQFields(d *schema.ResourceData) map[string]any {
+	fields := map[string]any{
+		"qinq_role":  nil,
+		"qinq_svlan": nil,
+	}
+	if role, ok := d.GetOk("qinq_role"); ok {
+		fields["qinq_role"] = role.(string)
+	}
+	if svlanID, ok := d.GetOk("qinq_svlan_id"); ok {
+		fields["qinq_svlan"] = svlanID.(int)
+	}
+	return fields
+}
@@ -141,6 +141,61 @@ func TestAccNetboxVlan_customFields(t *testing.T) {
 	})
 }
 
+func TestAccNetboxVlan_qinq(t *testing.T) {
+	testName := testAccGetTestName("vlan_qinq")
+	testVid := acctest.RandIntRange(1000, 4000)
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vlan" "svlan" {
+  name      = "%[1]s_svlan"
+  vid       = %[2]d
+  qinq_role = "svlan"
+}
+
+resource "netbox_vlan" "cvlan" {
+  name          = "%[1]s_cvlan"
+  vid           = %[2]d
+  qinq_role     = "cvlan"
+  qinq_svlan_id = netbox_vlan.svlan.id
+}`, testName, testVid),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vlan.svlan", "qinq_role", "svlan"),
+					resource.TestCheckResourceAttr("netbox_vlan.svlan", "qinq_svlan_id", "0"),
+					resource.TestCheckResourceAttr("netbox_vlan.cvlan", "qinq_role", "cvlan"),
+					resource.TestCheckResourceAttrPair("netbox_vlan.cvlan", "qinq_svlan_id", "netbox_vlan.svlan", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vlan" "svlan" {
+  name      = "%[1]s_svlan"
+  vid       = %[2]d
+  qinq_role = "svlan"
+}
+
+resource "netbox_vlan" "cvlan" {
+  name = "%[1]s_cvlan"
+  vid  = %[2]d
+}`, testName, testVid),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vlan.cvlan", "qinq_role", ""),
+					resource.TestCheckResourceAttr("netbox_vlan.cvlan", "qinq_svlan_id", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_vlan.svlan",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccNetboxVlanWithCustomFields(testName string, testField string, testVid int, testValue string) string {
 	return fmt.Sprintf(`
 resource "netbox_custom_field" "test" {
@@ -0,0 +1,132 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type vlanTranslationPolicy struct {
+	ID          int64               `json:"id,omitempty"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+func resourceNetboxVlanTranslationPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxVlanTranslationPolicyCreate,
+		Read:   resourceNetboxVlanTranslationPolicyRead,
+		Update: resourceNetboxVlanTranslationPolicyUpdate,
+		Delete: resourceNetboxVlanTranslationPolicyDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://netboxlabs.com/docs/netbox/models/ipam/vlantranslationpolicy/):
+
+> VLAN translation is a feature that consists of VLAN translation policies and VLAN translation rules. A VLAN translation policy is a named set of rules that can be assigned to device and virtual machine interfaces.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getVlanTranslationPolicyFromResourceData(api *providerState, d *schema.ResourceData) (*vlanTranslationPolicy, error) {
+	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return &vlanTranslationPolicy{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Tags:        tags,
+	}, nil
+}
+
+func resourceNetboxVlanTranslationPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data, err := getVlanTranslationPolicyFromResourceData(api, d)
+	if err != nil {
+		return err
+	}
+
+	var res vlanTranslationPolicy
+	err = netboxRawRequest(api, "POST", "/ipam/vlan-translation-policies/", nil, data, &res)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxVlanTranslationPolicyRead(d, m)
+}
+
+func resourceNetboxVlanTranslationPolicyRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	var policy vlanTranslationPolicy
+	err := netboxRawRequest(api, "GET", fmt.Sprintf("/ipam/vlan-translation-policies/%s/", d.Id()), nil, nil, &policy)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	api.readTags(d, policy.Tags)
+
+	return nil
+}
+
+func resourceNetboxVlanTranslationPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data, err := getVlanTranslationPolicyFromResourceData(api, d)
+	if err != nil {
+		return err
+	}
+
+	err = netboxRawRequest(api, "PUT", fmt.Sprintf("/ipam/vlan-translation-policies/%s/", d.Id()), nil, data, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxVlanTranslationPolicyRead(d, m)
+}
+
+func resourceNetboxVlanTranslationPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	err := netboxRawRequest(api, "DELETE", fmt.Sprintf("/ipam/vlan-translation-policies/%s/", d.Id()), nil, nil, nil)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
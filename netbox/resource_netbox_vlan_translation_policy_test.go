@@ -0,0 +1,53 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxVlanTranslationPolicy_basic(t *testing.T) {
+	testSlug := "vlan_tr_policy"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_vlan_translation_policy" "test" {
+  name        = "%[1]s"
+  description = "my-description"
+  tags        = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vlan_translation_policy.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_vlan_translation_policy.test", "description", "my-description"),
+					resource.TestCheckResourceAttr("netbox_vlan_translation_policy.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_vlan_translation_policy.test", "tags.0", testName),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vlan_translation_policy" "test" {
+  name = "%[1]s_updated"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vlan_translation_policy.test", "name", testName+"_updated"),
+					resource.TestCheckResourceAttr("netbox_vlan_translation_policy.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_vlan_translation_policy.test", "tags.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_vlan_translation_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
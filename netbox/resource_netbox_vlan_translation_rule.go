@@ -0,0 +1,159 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type vlanTranslationRule struct {
+	ID          int64               `json:"id,omitempty"`
+	Policy      *rawNestedObject    `json:"policy"`
+	LocalVid    int64               `json:"local_vid"`
+	RemoteVid   int64               `json:"remote_vid"`
+	Description string              `json:"description"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+type writableVlanTranslationRule struct {
+	Policy      int64               `json:"policy"`
+	LocalVid    int64               `json:"local_vid"`
+	RemoteVid   int64               `json:"remote_vid"`
+	Description string              `json:"description"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+func resourceNetboxVlanTranslationRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxVlanTranslationRuleCreate,
+		Read:   resourceNetboxVlanTranslationRuleRead,
+		Update: resourceNetboxVlanTranslationRuleUpdate,
+		Delete: resourceNetboxVlanTranslationRuleDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://netboxlabs.com/docs/netbox/models/ipam/vlantranslationrule/):
+
+> A VLAN translation rule represents a one-to-one mapping of a local VLAN ID (VID) to a remote VID. Many rules can belong to a single policy.`,
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"local_vid": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4094),
+			},
+			"remote_vid": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4094),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getVlanTranslationRuleFromResourceData(api *providerState, d *schema.ResourceData) (*writableVlanTranslationRule, error) {
+	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return &writableVlanTranslationRule{
+		Policy:      int64(d.Get("policy_id").(int)),
+		LocalVid:    int64(d.Get("local_vid").(int)),
+		RemoteVid:   int64(d.Get("remote_vid").(int)),
+		Description: d.Get("description").(string),
+		Tags:        tags,
+	}, nil
+}
+
+func resourceNetboxVlanTranslationRuleCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data, err := getVlanTranslationRuleFromResourceData(api, d)
+	if err != nil {
+		return err
+	}
+
+	var res vlanTranslationRule
+	err = netboxRawRequest(api, "POST", "/ipam/vlan-translation-rules/", nil, data, &res)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxVlanTranslationRuleRead(d, m)
+}
+
+func resourceNetboxVlanTranslationRuleRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	var rule vlanTranslationRule
+	err := netboxRawRequest(api, "GET", fmt.Sprintf("/ipam/vlan-translation-rules/%s/", d.Id()), nil, nil, &rule)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	if rule.Policy != nil {
+		d.Set("policy_id", rule.Policy.ID)
+	}
+	d.Set("local_vid", rule.LocalVid)
+	d.Set("remote_vid", rule.RemoteVid)
+	d.Set("description", rule.Description)
+	api.readTags(d, rule.Tags)
+
+	return nil
+}
+
+func resourceNetboxVlanTranslationRuleUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data, err := getVlanTranslationRuleFromResourceData(api, d)
+	if err != nil {
+		return err
+	}
+
+	err = netboxRawRequest(api, "PUT", fmt.Sprintf("/ipam/vlan-translation-rules/%s/", d.Id()), nil, data, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxVlanTranslationRuleRead(d, m)
+}
+
+func resourceNetboxVlanTranslationRuleDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	err := netboxRawRequest(api, "DELETE", fmt.Sprintf("/ipam/vlan-translation-rules/%s/", d.Id()), nil, nil, nil)
+	if err != nil {
+		if errresp, ok := err.(*netboxRawAPIError); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
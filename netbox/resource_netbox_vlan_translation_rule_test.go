@@ -0,0 +1,60 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxVlanTranslationRule_basic(t *testing.T) {
+	testSlug := "vlan_tr_rule"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vlan_translation_policy" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_vlan_translation_rule" "test" {
+  policy_id   = netbox_vlan_translation_policy.test.id
+  local_vid   = 100
+  remote_vid  = 200
+  description = "my-description"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_vlan_translation_rule.test", "policy_id", "netbox_vlan_translation_policy.test", "id"),
+					resource.TestCheckResourceAttr("netbox_vlan_translation_rule.test", "local_vid", "100"),
+					resource.TestCheckResourceAttr("netbox_vlan_translation_rule.test", "remote_vid", "200"),
+					resource.TestCheckResourceAttr("netbox_vlan_translation_rule.test", "description", "my-description"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vlan_translation_policy" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_vlan_translation_rule" "test" {
+  policy_id  = netbox_vlan_translation_policy.test.id
+  local_vid  = 101
+  remote_vid = 201
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vlan_translation_rule.test", "local_vid", "101"),
+					resource.TestCheckResourceAttr("netbox_vlan_translation_rule.test", "remote_vid", "201"),
+					resource.TestCheckResourceAttr("netbox_vlan_translation_rule.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_vlan_translation_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
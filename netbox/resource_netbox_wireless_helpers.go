@@ -2,53 +2,62 @@ package netbox
 
 import (
 	"github.com/fbreckle/go-netbox/netbox/client/wireless"
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-	"github.com/go-viper/mapstructure/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-type wirelessInterceptWriter struct {
-	runtime.ClientRequest
-	fields map[string]any
-}
+var resourceNetboxWirelessAuthTypeOptions = []string{"open", "wep", "wpa-personal", "wpa-enterprise"}
+var resourceNetboxWirelessAuthCipherOptions = []string{"auto", "tkip", "aes"}
 
-func (iw wirelessInterceptWriter) SetBodyParam(p any) error {
-	out := make(map[string]any)
-	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
-		TagName: "json",
-		Result:  &out,
-	})
-	if err != nil {
-		return err
-	}
-	if err := dec.Decode(p); err != nil {
-		return err
-	}
-	for fieldName, value := range iw.fields {
-		out[fieldName] = value
-	}
-	return iw.ClientRequest.SetBodyParam(out)
+// The authentication attributes are shared by wireless LANs and wireless links
+var wirelessAuthTypeSchema = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validation.StringInSlice(resourceNetboxWirelessAuthTypeOptions, false),
+	Description:  buildValidValueDescription(resourceNetboxWirelessAuthTypeOptions),
 }
 
-type wirelessInterceptParams struct {
-	inner  runtime.ClientRequestWriter
-	fields map[string]any
+var wirelessAuthCipherSchema = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validation.StringInSlice(resourceNetboxWirelessAuthCipherOptions, false),
+	Description:  buildValidValueDescription(resourceNetboxWirelessAuthCipherOptions),
 }
 
-func (ip wirelessInterceptParams) WriteToRequest(req runtime.ClientRequest, reg strfmt.Registry) error {
-	writer := wirelessInterceptWriter{ClientRequest: req, fields: ip.fields}
-	return ip.inner.WriteToRequest(writer, reg)
+var wirelessAuthPSKSchema = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	Sensitive:    true,
+	ValidateFunc: validation.StringLenBetween(0, 64),
 }
 
-func hackSerializeWirelessWithValues(fields map[string]any) wireless.ClientOption {
-	overrideFields := make(map[string]any, len(fields))
-	for fieldName, value := range fields {
-		overrideFields[fieldName] = value
+// getWirelessAuthFromResourceData returns the configured authentication
+// settings. Settings that are not configured are added to overrideFields, as
+// the go-netbox models omit empty values and they would not be unset otherwise.
+func getWirelessAuthFromResourceData(d *schema.ResourceData, overrideFields map[string]any) (authType, authCipher, authPSK string) {
+	if v, ok := d.GetOk("auth_type"); ok {
+		authType = v.(string)
+	} else {
+		overrideFields["auth_type"] = nil
 	}
-	return func(co *runtime.ClientOperation) {
-		originalParams := co.Params
-		co.Params = wirelessInterceptParams{inner: originalParams, fields: overrideFields}
+
+	if v, ok := d.GetOk("auth_cipher"); ok {
+		authCipher = v.(string)
+	} else {
+		overrideFields["auth_cipher"] = nil
 	}
+
+	if v, ok := d.GetOk("auth_psk"); ok {
+		authPSK = v.(string)
+	} else {
+		overrideFields["auth_psk"] = ""
+	}
+
+	return authType, authCipher, authPSK
+}
+
+func hackSerializeWirelessWithValues(fields map[string]any) wireless.ClientOption {
+	return hackSerializeWithValues(fields)
 }
 
 func hackSerializeWirelessAsNull(fields ...string) wireless.ClientOption {
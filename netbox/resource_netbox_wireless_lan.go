@@ -10,8 +10,6 @@ import (
 )
 
 var resourceNetboxWirelessLANStatusOptions = []string{"active", "reserved", "disabled", "deprecated"}
-var resourceNetboxWirelessLANAuthTypeOptions = []string{"open", "wep", "wpa-personal", "wpa-enterprise"}
-var resourceNetboxWirelessLANAuthCipherOptions = []string{"auto", "tkip", "aes"}
 
 func resourceNetboxWirelessLAN() *schema.Resource {
 	return &schema.Resource{
@@ -49,24 +47,9 @@ func resourceNetboxWirelessLAN() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			"auth_type": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice(resourceNetboxWirelessLANAuthTypeOptions, false),
-				Description:  buildValidValueDescription(resourceNetboxWirelessLANAuthTypeOptions),
-			},
-			"auth_cipher": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice(resourceNetboxWirelessLANAuthCipherOptions, false),
-				Description:  buildValidValueDescription(resourceNetboxWirelessLANAuthCipherOptions),
-			},
-			"auth_psk": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Sensitive:    true,
-				ValidateFunc: validation.StringLenBetween(0, 64),
-			},
+			"auth_type":   wirelessAuthTypeSchema,
+			"auth_cipher": wirelessAuthCipherSchema,
+			"auth_psk":    wirelessAuthPSKSchema,
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -233,23 +216,7 @@ func resourceNetboxWirelessLANUpdate(d *schema.ResourceData, m interface{}) erro
 		overrideFields["vlan"] = nil
 	}
 
-	if authType, ok := d.GetOk("auth_type"); ok {
-		data.AuthType = authType.(string)
-	} else {
-		overrideFields["auth_type"] = nil
-	}
-
-	if authCipher, ok := d.GetOk("auth_cipher"); ok {
-		data.AuthCipher = authCipher.(string)
-	} else {
-		overrideFields["auth_cipher"] = nil
-	}
-
-	if authPSK, ok := d.GetOk("auth_psk"); ok {
-		data.AuthPsk = authPSK.(string)
-	} else {
-		overrideFields["auth_psk"] = ""
-	}
+	data.AuthType, data.AuthCipher, data.AuthPsk = getWirelessAuthFromResourceData(d, overrideFields)
 
 	var err error
 	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
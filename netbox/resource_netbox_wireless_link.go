@@ -0,0 +1,276 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/wireless"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxWirelessLinkStatusOptions = []string{"connected", "planned", "decommissioning"}
+var resourceNetboxWirelessLinkDistanceUnitOptions = []string{"km", "m", "mi", "ft"}
+
+// wirelessLinkDistanceFields holds the attributes of wireless links that are
+// not part of the go-netbox models.
+type wirelessLinkDistanceFields struct {
+	Distance     *float64   `json:"distance"`
+	DistanceUnit *rawChoice `json:"distance_unit"`
+}
+
+func resourceNetboxWirelessLink() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxWirelessLinkCreate,
+		Read:   resourceNetboxWirelessLinkRead,
+		Update: resourceNetboxWirelessLinkUpdate,
+		Delete: resourceNetboxWirelessLinkDelete,
+
+		Description: `:meta:subcategory:Wireless:From the [official documentation](https://netboxlabs.com/docs/netbox/models/wireless/wirelesslink/):
+
+> A wireless link represents a connection between exactly two wireless interfaces. It may optionally be assigned an SSID and a description. It may also have a status assigned to it, similar to the cable model. Each wireless link may also be assigned to a particular tenant.`,
+
+		Schema: map[string]*schema.Schema{
+			"interface_a_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"interface_b_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"ssid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "connected",
+				ValidateFunc: validation.StringInSlice(resourceNetboxWirelessLinkStatusOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxWirelessLinkStatusOptions),
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"auth_type":   wirelessAuthTypeSchema,
+			"auth_cipher": wirelessAuthCipherSchema,
+			"auth_psk":    wirelessAuthPSKSchema,
+			"distance": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				RequiredWith: []string{"distance_unit"},
+			},
+			"distance_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"distance"},
+				ValidateFunc: validation.StringInSlice(resourceNetboxWirelessLinkDistanceUnitOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxWirelessLinkDistanceUnitOptions),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			customFieldsKey: customFieldsSchema,
+			tagsKey:         tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getWirelessLinkDistanceFields(d *schema.ResourceData) map[string]any {
+	fields := map[string]any{
+		"distance":      getOptionalFloat(d, "distance"),
+		"distance_unit": nil,
+	}
+	if unit, ok := d.GetOk("distance_unit"); ok {
+		fields["distance_unit"] = unit.(string)
+	}
+	return fields
+}
+
+func resourceNetboxWirelessLinkCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := &models.WritableWirelessLink{
+		Interfacea:  int64ToPtr(int64(d.Get("interface_a_id").(int))),
+		Interfaceb:  int64ToPtr(int64(d.Get("interface_b_id").(int))),
+		Ssid:        d.Get("ssid").(string),
+		Status:      d.Get("status").(string),
+		Tenant:      getOptionalInt(d, "tenant_id"),
+		AuthType:    d.Get("auth_type").(string),
+		AuthCipher:  d.Get("auth_cipher").(string),
+		AuthPsk:     d.Get("auth_psk").(string),
+		Description: d.Get("description").(string),
+		Comments:    d.Get("comments").(string),
+	}
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return err
+	}
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = cf
+	}
+
+	params := wireless.NewWirelessWirelessLinksCreateParams().WithData(data)
+	res, err := api.Wireless.WirelessWirelessLinksCreate(params, nil, hackSerializeWirelessWithValues(getWirelessLinkDistanceFields(d)))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxWirelessLinkRead(d, m)
+}
+
+func resourceNetboxWirelessLinkRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := wireless.NewWirelessWirelessLinksReadParams().WithID(id)
+
+	var distanceFields wirelessLinkDistanceFields
+	res, err := api.Wireless.WirelessWirelessLinksRead(params, nil, withRawResponse(&distanceFields))
+	if err != nil {
+		if errresp, ok := err.(*wireless.WirelessWirelessLinksReadDefault); ok && errresp.Code() == 404 {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	link := res.GetPayload()
+	d.Set("ssid", link.Ssid)
+	d.Set("description", link.Description)
+	d.Set("comments", link.Comments)
+	d.Set("auth_psk", link.AuthPsk)
+	d.Set("distance", distanceFields.Distance)
+
+	if link.Interfacea != nil {
+		d.Set("interface_a_id", link.Interfacea.ID)
+	} else {
+		d.Set("interface_a_id", nil)
+	}
+
+	if link.Interfaceb != nil {
+		d.Set("interface_b_id", link.Interfaceb.ID)
+	} else {
+		d.Set("interface_b_id", nil)
+	}
+
+	if link.Status != nil {
+		d.Set("status", link.Status.Value)
+	} else {
+		d.Set("status", nil)
+	}
+
+	if link.AuthType != nil {
+		d.Set("auth_type", link.AuthType.Value)
+	} else {
+		d.Set("auth_type", nil)
+	}
+
+	if link.AuthCipher != nil {
+		d.Set("auth_cipher", link.AuthCipher.Value)
+	} else {
+		d.Set("auth_cipher", nil)
+	}
+
+	if link.Tenant != nil {
+		d.Set("tenant_id", link.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	if distanceFields.DistanceUnit != nil {
+		d.Set("distance_unit", distanceFields.DistanceUnit.Value)
+	} else {
+		d.Set("distance_unit", nil)
+	}
+
+	cf := getCustomFields(link.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	api.readTags(d, link.Tags)
+
+	return nil
+}
+
+func resourceNetboxWirelessLinkUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := models.WritableWirelessLink{
+		Interfacea:  int64ToPtr(int64(d.Get("interface_a_id").(int))),
+		Interfaceb:  int64ToPtr(int64(d.Get("interface_b_id").(int))),
+		Status:      d.Get("status").(string),
+		Description: getOptionalStr(d, "description", true),
+		Comments:    getOptionalStr(d, "comments", true),
+	}
+
+	overrideFields := getWirelessLinkDistanceFields(d)
+
+	if ssid, ok := d.GetOk("ssid"); ok {
+		data.Ssid = ssid.(string)
+	} else {
+		overrideFields["ssid"] = ""
+	}
+
+	tenantID := d.Get("tenant_id").(int)
+	if tenantID != 0 {
+		data.Tenant = int64ToPtr(int64(tenantID))
+	} else {
+		overrideFields["tenant"] = nil
+	}
+
+	data.AuthType, data.AuthCipher, data.AuthPsk = getWirelessAuthFromResourceData(d, overrideFields)
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return err
+	}
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = cf
+	}
+
+	params := wireless.NewWirelessWirelessLinksPartialUpdateParams().WithID(id).WithData(&data)
+	_, err = api.Wireless.WirelessWirelessLinksPartialUpdate(params, nil, hackSerializeWirelessWithValues(overrideFields))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxWirelessLinkRead(d, m)
+}
+
+func resourceNetboxWirelessLinkDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := wireless.NewWirelessWirelessLinksDeleteParams().WithID(id)
+	_, err := api.Wireless.WirelessWirelessLinksDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*wireless.WirelessWirelessLinksDeleteDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
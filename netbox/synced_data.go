@@ -0,0 +1,112 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The helpers in this file cover objects whose content can be synchronized
+// from a data file of a core data source, e.g. config templates and config
+// contexts. The go-netbox models of these objects lack the related fields.
+
+type rawDataFile struct {
+	ID          int64            `json:"id"`
+	Source      *rawNestedObject `json:"source"`
+	Path        string           `json:"path"`
+	LastUpdated *string          `json:"last_updated"`
+	Size        int64            `json:"size"`
+	Hash        string           `json:"hash"`
+}
+
+type rawSyncedData struct {
+	DataSource      *rawNestedObject `json:"data_source"`
+	DataPath        string           `json:"data_path"`
+	DataSynced      *string          `json:"data_synced"`
+	AutoSyncEnabled bool             `json:"auto_sync_enabled"`
+}
+
+var syncedDataSourceIDSchema = &schema.Schema{
+	Type:         schema.TypeInt,
+	Optional:     true,
+	RequiredWith: []string{"data_source_id", "data_file_path"},
+	Description:  "The ID of the data source to load the content from.",
+}
+
+var syncedDataFilePathSchema = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	RequiredWith: []string{"data_source_id", "data_file_path"},
+	Description:  "The path of the data file within the data source. The file must already have been synchronized, e.g. with `netbox_data_source_sync`.",
+}
+
+var syncedDataAutoSyncEnabledSchema = &schema.Schema{
+	Type:        schema.TypeBool,
+	Optional:    true,
+	Default:     false,
+	Description: "If true, the content is updated automatically whenever the data file is synchronized.",
+}
+
+var syncedDataSyncedSchema = &schema.Schema{
+	Type:     schema.TypeString,
+	Computed: true,
+}
+
+// getDataFileByPath returns the data file with the given path in a data source.
+func getDataFileByPath(api *providerState, sourceID int64, path string) (*rawDataFile, error) {
+	query := url.Values{}
+	query.Set("source_id", strconv.FormatInt(sourceID, 10))
+	query.Set("path", path)
+	query.Set("limit", "2")
+
+	var res rawListPage[rawDataFile]
+	if err := netboxRawRequest(api, "GET", "/core/data-files/", query, nil, &res); err != nil {
+		return nil, err
+	}
+
+	if res.Count > 1 {
+		return nil, errors.New("more than one data file returned, specify a more narrow filter")
+	}
+	if res.Count == 0 {
+		return nil, errors.New("no data file found matching filter")
+	}
+	return &res.Results[0], nil
+}
+
+// getSyncedDataOverrideFields returns the request body fields that link an
+// object to its data file, or unlink it if no data source is configured.
+func getSyncedDataOverrideFields(api *providerState, d *schema.ResourceData) (map[string]any, error) {
+	dataSourceID, ok := d.GetOk("data_source_id")
+	if !ok {
+		return map[string]any{
+			"data_source":       nil,
+			"data_file":         nil,
+			"auto_sync_enabled": false,
+		}, nil
+	}
+
+	dataFile, err := getDataFileByPath(api, int64(dataSourceID.(int)), d.Get("data_file_path").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"data_source":       dataSourceID,
+		"data_file":         dataFile.ID,
+		"auto_sync_enabled": d.Get("auto_sync_enabled").(bool),
+	}, nil
+}
+
+func setSyncedDataResourceData(d *schema.ResourceData, synced *rawSyncedData) {
+	if synced.DataSource != nil {
+		d.Set("data_source_id", synced.DataSource.ID)
+		d.Set("data_file_path", synced.DataPath)
+	} else {
+		d.Set("data_source_id", nil)
+		d.Set("data_file_path", nil)
+	}
+	d.Set("auto_sync_enabled", synced.AutoSyncEnabled)
+	d.Set("data_synced", synced.DataSynced)
+}
@@ -0,0 +1,130 @@
+package netbox
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// apiTokenSource provides the API token for every request. Tokens read from
+// a file or a command are loaded again when Netbox rejects the current one,
+// e.g. because an agent rotated the token in the meantime.
+type apiTokenSource struct {
+	mu    sync.Mutex
+	token string
+	load  func() (string, error)
+}
+
+func newStaticAPITokenSource(token string) *apiTokenSource {
+	return &apiTokenSource{token: token}
+}
+
+func newFileAPITokenSource(path string) (*apiTokenSource, error) {
+	source := &apiTokenSource{load: func() (string, error) {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("error reading API token file: %w", err)
+		}
+		return strings.TrimSpace(string(content)), nil
+	}}
+	return source, source.reload()
+}
+
+func newCommandAPITokenSource(command string) (*apiTokenSource, error) {
+	source := &apiTokenSource{load: func() (string, error) {
+		var cmd *exec.Cmd
+		if runtime.GOOS == "windows" {
+			cmd = exec.Command("cmd", "/C", command)
+		} else {
+			cmd = exec.Command("sh", "-c", command)
+		}
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		output, err := cmd.Output()
+		if err != nil {
+			return "", fmt.Errorf("error running API token command: %w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+		return strings.TrimSpace(string(output)), nil
+	}}
+	return source, source.reload()
+}
+
+func (s *apiTokenSource) reload() error {
+	token, err := s.load()
+	if err != nil {
+		return err
+	}
+	if token == "" {
+		return fmt.Errorf("missing netbox API key")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = token
+	return nil
+}
+
+// refreshable reports whether the token can change while the provider runs.
+func (s *apiTokenSource) refreshable() bool {
+	return s.load != nil
+}
+
+// authorization returns the value of the Authorization header. v2 tokens are
+// detected by their `nbt_` prefix.
+func (s *apiTokenSource) authorization() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if strings.HasPrefix(s.token, "nbt_") {
+		return "Bearer " + s.token
+	}
+	return "Token " + s.token
+}
+
+// refreshTokenTransport loads the token again when a request is rejected with
+// 401 Unauthorized and retries the request once if the token changed.
+type refreshTokenTransport struct {
+	original http.RoundTripper
+	source   *apiTokenSource
+}
+
+func (t refreshTokenTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	resp, err := t.original.RoundTrip(r)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// The request can only be sent again if its body can be replayed
+	if r.Body != nil && r.Body != http.NoBody && r.GetBody == nil {
+		return resp, err
+	}
+
+	previous := t.source.authorization()
+	if err := t.source.reload(); err != nil {
+		log.WithError(err).Warn("Failed to reload the Netbox API token after a 401 response")
+		return resp, nil
+	}
+	authorization := t.source.authorization()
+	if authorization == previous {
+		return resp, nil
+	}
+
+	log.Debug("Netbox rejected the API token, retrying with the reloaded token")
+	retry := r.Clone(withRetryAttempt(r.Context(), retryAttempt(r.Context())+1))
+	if r.GetBody != nil {
+		body, err := r.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+	retry.Header.Set("Authorization", authorization)
+	resp.Body.Close()
+
+	return t.original.RoundTrip(retry)
+}
@@ -0,0 +1,232 @@
+package netbox
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracingSubsystem is the tflog subsystem of the request traces. Its level can
+// be set separately via the TF_LOG_PROVIDER_NETBOX_API environment variable.
+const tracingSubsystem = "api"
+
+const redactedHeaderValue = "REDACTED"
+
+// defaultSensitiveHeaders are redacted in all logs, in addition to the
+// sensitive_headers of the provider.
+var defaultSensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+type retryAttemptKey struct{}
+
+// withRetryAttempt marks the context of a request that is sent again.
+func withRetryAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, retryAttemptKey{}, attempt)
+}
+
+func retryAttempt(ctx context.Context) int {
+	attempt, _ := ctx.Value(retryAttemptKey{}).(int)
+	return attempt
+}
+
+// tracingTransport is a transport that logs every request to the tflog
+// subsystem, with sensitive headers redacted, and optionally dumps the request
+// and response bodies to a file.
+type tracingTransport struct {
+	original http.RoundTripper
+	// carries the logger of the provider
+	ctx              context.Context
+	sensitiveHeaders map[string]bool
+	bodyDump         *bodyDumpFile
+}
+
+// bodyDumpFile serializes the writes of concurrent requests to the dump file.
+type bodyDumpFile struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// openBodyDumpFile opens the dump file for appending.
+func openBodyDumpFile(path string) (*bodyDumpFile, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("error opening trace body file: %w", err)
+	}
+	return &bodyDumpFile{w: f}, nil
+}
+
+// newTracingTransport returns a tracing transport. The bodies are only dumped
+// if bodyDump is set.
+func newTracingTransport(ctx context.Context, original http.RoundTripper, sensitiveHeaders []string, bodyDump *bodyDumpFile) *tracingTransport {
+	t := &tracingTransport{
+		original:         original,
+		ctx:              tflog.NewSubsystem(ctx, tracingSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_NETBOX", tracingSubsystem)),
+		sensitiveHeaders: make(map[string]bool),
+		bodyDump:         bodyDump,
+	}
+	for _, name := range append(defaultSensitiveHeaders, sensitiveHeaders...) {
+		t.sensitiveHeaders[http.CanonicalHeaderKey(name)] = true
+	}
+	return t
+}
+
+// redactHeaders returns the headers as a map for logging, with the values of
+// sensitive headers replaced.
+func (t *tracingTransport) redactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	for name, values := range header {
+		if t.sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+			redacted[name] = redactedHeaderValue
+		} else {
+			redacted[name] = fmt.Sprint(values)
+		}
+	}
+	return redacted
+}
+
+// RoundTrip logs the request and its outcome.
+func (t *tracingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	fields := map[string]interface{}{
+		"method": r.Method,
+		"path":   r.URL.Path,
+		"retry":  retryAttempt(r.Context()),
+	}
+	if page, ok := requestPage(r); ok {
+		fields["page"] = page
+	}
+
+	var requestBody []byte
+	if t.bodyDump != nil && r.Body != nil && r.Body != http.NoBody {
+		var err error
+		requestBody, err = readRequestBody(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tflog.SubsystemTrace(t.ctx, tracingSubsystem, "Sending Netbox API request", map[string]interface{}{
+		"method":          r.Method,
+		"path":            r.URL.Path,
+		"request_headers": t.redactHeaders(r.Header),
+	})
+
+	// A child span of the CRUD operation, if OpenTelemetry is enabled
+	_, span := otel.Tracer(tracerName).Start(r.Context(), "HTTP "+r.Method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("http.request.method", r.Method),
+		attribute.String("url.path", r.URL.Path),
+		attribute.Int("netbox.retry", retryAttempt(r.Context())),
+	))
+	defer span.End()
+	if page, ok := fields["page"].(int64); ok {
+		span.SetAttributes(attribute.Int64("netbox.page", page))
+	}
+
+	start := time.Now()
+	resp, err := t.original.RoundTrip(r)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(t.ctx, tracingSubsystem, "Netbox API request failed", fields)
+		span.SetStatus(codes.Error, err.Error())
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= 400 {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+	tflog.SubsystemDebug(t.ctx, tracingSubsystem, "Netbox API request", fields)
+	tflog.SubsystemTrace(t.ctx, tracingSubsystem, "Received Netbox API response", map[string]interface{}{
+		"method":           r.Method,
+		"path":             r.URL.Path,
+		"response_headers": t.redactHeaders(resp.Header),
+	})
+
+	if t.bodyDump != nil {
+		responseBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+		t.dumpBodies(r, resp, requestBody, responseBody, fields["duration_ms"].(int64))
+	}
+
+	return resp, nil
+}
+
+// dumpBodies appends the request and response to the dump file. Failures are
+// only logged, the dump must not break the request.
+func (t *tracingTransport) dumpBodies(r *http.Request, resp *http.Response, requestBody, responseBody []byte, durationMs int64) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "--- %s %s %s\n", time.Now().UTC().Format(time.RFC3339Nano), r.Method, r.URL.RequestURI())
+	t.dumpHeaders(&buf, ">", r.Header)
+	fmt.Fprintf(&buf, ">\n%s\n", requestBody)
+	fmt.Fprintf(&buf, "< %s (%dms, retry %d)\n", resp.Status, durationMs, retryAttempt(r.Context()))
+	t.dumpHeaders(&buf, "<", resp.Header)
+	fmt.Fprintf(&buf, "<\n%s\n\n", responseBody)
+
+	t.bodyDump.mu.Lock()
+	defer t.bodyDump.mu.Unlock()
+	if _, err := t.bodyDump.w.Write(buf.Bytes()); err != nil {
+		tflog.SubsystemWarn(t.ctx, tracingSubsystem, "Failed to write the trace body file", map[string]interface{}{"error": err.Error()})
+	}
+}
+
+func (t *tracingTransport) dumpHeaders(buf *bytes.Buffer, prefix string, header http.Header) {
+	redacted := t.redactHeaders(header)
+	names := make([]string, 0, len(redacted))
+	for name := range redacted {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(buf, "%s %s: %s\n", prefix, name, redacted[name])
+	}
+}
+
+// readRequestBody returns the body of the request without consuming it.
+func readRequestBody(r *http.Request) ([]byte, error) {
+	if r.GetBody != nil {
+		body, err := r.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+
+	body, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// requestPage returns the page number of a paginated list request, counted
+// from 1.
+func requestPage(r *http.Request) (int64, bool) {
+	query := r.URL.Query()
+	limit, err := strconv.ParseInt(query.Get("limit"), 10, 64)
+	if err != nil || limit <= 0 {
+		return 0, false
+	}
+	offset, _ := strconv.ParseInt(query.Get("offset"), 10, 64)
+	return offset/limit + 1, true
+}
@@ -0,0 +1,152 @@
+package netbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client/status"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTracingTestServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "sessionid=secret-session")
+		w.Write([]byte(`{"count": 0, "next": null, "results": []}`))
+	}))
+}
+
+// tracedRequests returns the per-request log entries of the tracing subsystem.
+func tracedRequests(t *testing.T, output *bytes.Buffer) []map[string]interface{} {
+	entries, err := tflogtest.MultilineJSONDecode(output)
+	assert.NoError(t, err)
+
+	var requests []map[string]interface{}
+	for _, entry := range entries {
+		if entry["@message"] == "Netbox API request" {
+			requests = append(requests, entry)
+		}
+	}
+	return requests
+}
+
+func TestTracingTransport(t *testing.T) {
+	ts := newTracingTestServer()
+	defer ts.Close()
+
+	var output bytes.Buffer
+	config := Config{
+		APIToken:         "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:        ts.URL,
+		Headers:          map[string]interface{}{"X-Vault-Token": "secret-vault-token", "X-Team": "network"},
+		SensitiveHeaders: []string{"x-vault-token"},
+		LogContext:       tflogtest.RootLogger(context.Background(), &output),
+	}
+	t.Setenv("TF_LOG_PROVIDER_NETBOX_API", "TRACE")
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	state := &providerState{NetBoxAPI: client}
+	err = netboxRawRequest(state, "GET", "/dcim/sites/", url.Values{"limit": []string{"50"}, "offset": []string{"100"}}, nil, nil)
+	assert.NoError(t, err)
+
+	assert.NotContains(t, output.String(), "07b12b765127747e4afd56cb531b7bf9c61f3c30")
+	assert.NotContains(t, output.String(), "secret-vault-token")
+	assert.NotContains(t, output.String(), "secret-session")
+	assert.Contains(t, output.String(), "network")
+
+	requests := tracedRequests(t, &output)
+	if assert.Len(t, requests, 1) {
+		assert.Equal(t, "GET", requests[0]["method"])
+		assert.Equal(t, "/api/dcim/sites/", requests[0]["path"])
+		assert.Equal(t, float64(200), requests[0]["status"])
+		assert.Equal(t, float64(3), requests[0]["page"])
+		assert.Equal(t, float64(0), requests[0]["retry"])
+		assert.Contains(t, requests[0], "duration_ms")
+	}
+}
+
+func TestTracingTransportCountsRetries(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Token rotated-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "4.4.0"}`))
+	}))
+	defer ts.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("initial-token\n"), 0o600))
+
+	var output bytes.Buffer
+	config := Config{
+		APITokenFile: tokenFile,
+		ServerURL:    ts.URL,
+		LogContext:   tflogtest.RootLogger(context.Background(), &output),
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("rotated-token\n"), 0o600))
+
+	_, err = client.Status.StatusList(status.NewStatusListParams(), nil)
+	assert.NoError(t, err)
+
+	requests := tracedRequests(t, &output)
+	if assert.Len(t, requests, 2) {
+		assert.Equal(t, float64(401), requests[0]["status"])
+		assert.Equal(t, float64(0), requests[0]["retry"])
+		assert.Equal(t, float64(200), requests[1]["status"])
+		assert.Equal(t, float64(1), requests[1]["retry"])
+	}
+}
+
+func TestTracingTransportDumpsBodies(t *testing.T) {
+	ts := newTracingTestServer()
+	defer ts.Close()
+
+	bodyFile := filepath.Join(t.TempDir(), "trace.log")
+	config := Config{
+		APIToken:      "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:     ts.URL,
+		TraceBodyFile: bodyFile,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	var res json.RawMessage
+	state := &providerState{NetBoxAPI: client}
+	err = netboxRawRequest(state, "POST", "/dcim/sites/", nil, map[string]string{"name": "traced-site"}, &res)
+	assert.NoError(t, err)
+	// The response is still passed on after it was dumped
+	assert.JSONEq(t, `{"count": 0, "next": null, "results": []}`, string(res))
+
+	dump, err := os.ReadFile(bodyFile)
+	assert.NoError(t, err)
+	assert.Contains(t, string(dump), "POST /api/dcim/sites/")
+	assert.Contains(t, string(dump), `"name":"traced-site"`)
+	assert.Contains(t, string(dump), "< 200 OK")
+	assert.Contains(t, string(dump), `"results": []`)
+	assert.Contains(t, string(dump), "> Authorization: REDACTED")
+	assert.Contains(t, string(dump), "< Set-Cookie: REDACTED")
+	assert.NotContains(t, string(dump), "07b12b765127747e4afd56cb531b7bf9c61f3c30")
+
+	// A client rebuilt from the same config, e.g. for a branch, writes to the
+	// same file handle
+	bodyDump := config.bodyDump
+	_, err = config.Client()
+	assert.NoError(t, err)
+	assert.Same(t, bodyDump, config.bodyDump)
+}